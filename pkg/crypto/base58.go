@@ -1,11 +1,34 @@
 package crypto
 
-import "github.com/btcsuite/btcutil/base58"
+import (
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/pkg/errors"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
 
 func Base58Encode(b []byte) string {
 	return base58.Encode(b)
 }
 
+/**
+ * Decode a base58 string, invalid input gives an empty slice. Use Base58DecodeStrict for user input.
+ */
 func Base58Decode(s string) []byte {
 	return base58.Decode(s)
 }
+
+/**
+ * Decode a base58 string, failing on characters outside of the alphabet. An empty string gives an empty slice.
+ */
+func Base58DecodeStrict(s string) ([]byte, error) {
+	for i, c := range s {
+		if !strings.ContainsRune(base58Alphabet, c) {
+			return nil, errors.Errorf("invalid base58 character %q at position %d", c, i)
+		}
+	}
+
+	return base58.Decode(s), nil
+}
@@ -58,3 +58,45 @@ func TestBase58Decode(t *testing.T) {
 		})
 	}
 }
+
+func TestBase58DecodeStrict(t *testing.T) {
+	cases := map[string]struct {
+		Input          string
+		ExpectedOutput []byte
+		ExpectedError  string
+	}{
+		"base58 decode string": {
+			Input:          b58Str,
+			ExpectedOutput: b58Bytes,
+		},
+		"base58 decode empty string": {
+			Input:          "",
+			ExpectedOutput: []byte{},
+		},
+		"base58 decode leading zeros": {
+			Input:          "11",
+			ExpectedOutput: []byte{0, 0},
+		},
+		"base58 decode invalid character": {
+			Input:         "3N6mZMgGqYn0EVAR",
+			ExpectedError: "invalid base58 character '0' at position 11",
+		},
+		"base58 decode non ascii character": {
+			Input:         "3N6mZé",
+			ExpectedError: "invalid base58 character 'é' at position 5",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := crypto.Base58DecodeStrict(tc.Input)
+			if tc.ExpectedError != "" {
+				require.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.ExpectedOutput, result)
+		})
+	}
+}
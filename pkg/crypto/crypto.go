@@ -10,7 +10,14 @@ import (
 )
 
 func BuildNACLSignKeyPair(seed []byte) (*KeyPair, error) {
-	seedHash, err := buildSeedHash(seed)
+	return BuildNACLSignKeyPairWithNonce(seed, InitialNonce)
+}
+
+/**
+ * Derive a key pair from the seed, each nonce gives a different key pair
+ */
+func BuildNACLSignKeyPairWithNonce(seed []byte, nonce int32) (*KeyPair, error) {
+	seedHash, err := buildSeedHash(seed, nonce)
 	if err != nil {
 		return nil, err
 	}
@@ -28,16 +35,17 @@ const AddressVersion byte = 0x1
 const PrivateKeyLength = 64
 const PublicKeyLength = 32
 const SignatureLength = 64
+const AddressLength = 26
 
-func buildSeedHash(seed []byte) ([]byte, error) {
-	nonce := new(bytes.Buffer)
+func buildSeedHash(seed []byte, nonce int32) ([]byte, error) {
+	buf := new(bytes.Buffer)
 
-	err := binary.Write(nonce, binary.BigEndian, InitialNonce)
+	err := binary.Write(buf, binary.BigEndian, nonce)
 	if err != nil {
 		return nil, err
 	}
 
-	seedBytesWithNonce := append(nonce.Bytes(), seed...)
+	seedBytesWithNonce := append(buf.Bytes(), seed...)
 
 	seedHash := hashChain(seedBytesWithNonce)
 	return Sha256(seedHash), nil
@@ -100,7 +108,7 @@ func BuildNACLSignKeyPairFromSecret(privateKey []byte) *KeyPair {
 }
 
 func IsValidAddress(address []byte, networkByte byte) bool {
-	if len(address) < 2 || address[0] != AddressVersion || address[1] != networkByte {
+	if len(address) != AddressLength || address[0] != AddressVersion || address[1] != networkByte {
 		return false
 	}
 
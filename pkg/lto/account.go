@@ -1,9 +1,7 @@
 package lto
 
 import (
-	cryptorand "crypto/rand"
-	"math/big"
-	"strings"
+	"math"
 
 	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
 	"github.com/pkg/errors"
@@ -14,7 +12,10 @@ type accountParams struct {
 	networkConfig *Config
 	seed          []byte
 	privateKey    []byte
+	mnemonic      string
 	randomWordN   int
+	wordlist      Wordlist
+	nonce         int32
 }
 
 func NewAccount() *accountParams {
@@ -24,60 +25,126 @@ func NewAccount() *accountParams {
 		privateKey:    nil,
 		networkConfig: nil,
 		randomWordN:   15,
+		wordlist:      WordlistEnglish,
 	}
 }
 
 func (p *accountParams) Create() (*Account, error) {
-	if p.networkConfig == nil {
-		switch p.network {
-		case NetworkMain:
-			p.networkConfig = DefaultMainNetConfig()
-		case NetworkTest:
-			p.networkConfig = DefaultTestNetConfig()
-		default:
-			return nil, errors.New("invalid network")
-		}
+	err := p.fillNetworkConfig()
+	if err != nil {
+		return nil, err
 	}
 
 	if len(p.privateKey) != 0 {
-		sign := crypto.BuildNACLSignKeyPairFromSecret(p.privateKey)
-		address := crypto.BuildRawAddress(sign.PublicKey, byte(p.networkConfig.Network))
+		if p.nonce != 0 {
+			return nil, errors.New("a nonce can only be used with a seed")
+		}
 
+		sign := crypto.BuildNACLSignKeyPairFromSecret(p.privateKey)
 		return &Account{
-			Address: address,
+			Address: NewAddress(sign.PublicKey, p.networkConfig.Network),
 			Sign:    sign,
 		}, nil
 	}
 
+	seed, err := p.getSeed()
+	if err != nil {
+		return nil, err
+	}
+
+	return newAccountFromSeed(seed, p.nonce, p.networkConfig)
+}
+
+/**
+ * Derive count accounts from the same seed, starting at the nonce of WithNonce. A random seed is generated once
+ * and shared by all accounts.
+ */
+func (p *accountParams) CreateRange(count int) ([]*Account, error) {
+	if count < 0 {
+		return nil, errors.New("invalid number of accounts")
+	}
+
+	if int64(p.nonce)+int64(count)-1 > math.MaxInt32 {
+		return nil, errors.Errorf("nonce %d plus %d accounts exceeds the maximum nonce", p.nonce, count)
+	}
+
+	err := p.fillNetworkConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(p.privateKey) != 0 {
+		return nil, errors.New("a range of accounts can only be derived from a seed")
+	}
+
+	seed, err := p.getSeed()
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*Account, count)
+	for i := range accounts {
+		accounts[i], err = newAccountFromSeed(seed, p.nonce+int32(i), p.networkConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return accounts, nil
+}
+
+func (p *accountParams) fillNetworkConfig() error {
+	if p.networkConfig != nil {
+		return nil
+	}
+
+	switch p.network {
+	case NetworkMain:
+		p.networkConfig = DefaultMainNetConfig()
+	case NetworkTest:
+		p.networkConfig = DefaultTestNetConfig()
+	default:
+		return errors.New("invalid network")
+	}
+
+	return nil
+}
+
+func (p *accountParams) getSeed() ([]byte, error) {
 	if len(p.seed) != 0 {
-		return newAccountFromSeed(p.seed, p.networkConfig)
+		return p.seed, nil
 	}
 
-	if p.randomWordN != 0 {
-		seed, err := generateNewSeed(p.randomWordN)
+	if p.mnemonic != "" {
+		err := ValidateMnemonic(p.mnemonic)
 		if err != nil {
 			return nil, err
 		}
 
-		return newAccountFromSeed(seed, p.networkConfig)
+		return []byte(normalizeMnemonic(p.mnemonic)), nil
+	}
+
+	if p.randomWordN != 0 {
+		return generateNewSeed(p.randomWordN, p.wordlist)
 	}
 
 	return nil, errors.New("no method specified for generating the private key")
 }
 
-func newAccountFromSeed(seed []byte, networkConfig *Config) (*Account, error) {
+func newAccountFromSeed(seed []byte, nonce int32, networkConfig *Config) (*Account, error) {
 	if len(seed) < networkConfig.MinimumSeedLength {
 		return nil, errors.Errorf("seed must have a length of at least %d", networkConfig.MinimumSeedLength)
 	}
 
-	keys, err := crypto.BuildNACLSignKeyPair(seed)
+	keys, err := crypto.BuildNACLSignKeyPairWithNonce(seed, nonce)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Account{
-		Address: crypto.BuildRawAddress(keys.PublicKey, byte(networkConfig.Network)),
+		Address: NewAddress(keys.PublicKey, networkConfig.Network),
 		Seed:    seed,
+		Nonce:   nonce,
 		Sign:    keys,
 	}, nil
 }
@@ -94,18 +161,39 @@ func (p *accountParams) FromSeed(seed []byte) *accountParams {
 	return p
 }
 
+/**
+ * Use a BIP39 mnemonic as seed, the words and checksum are validated so a mistyped word is caught
+ */
+func (p *accountParams) FromMnemonic(mnemonic string) *accountParams {
+	p.mnemonic = mnemonic
+
+	return p
+}
+
 func (p *accountParams) FromRandom() *accountParams {
 	p.randomWordN = 15
 
 	return p
 }
 
+/**
+ * Generate a random BIP39 mnemonic of 12, 15, 18, 21 or 24 words
+ */
 func (p *accountParams) FromRandomN(n int) *accountParams {
 	p.randomWordN = n
 
 	return p
 }
 
+/**
+ * Wordlist of the generated mnemonic, English by default
+ */
+func (p *accountParams) WithWordlist(wordlist Wordlist) *accountParams {
+	p.wordlist = wordlist
+
+	return p
+}
+
 func (p *accountParams) WithNetwork(network Network) *accountParams {
 	p.network = network
 
@@ -118,17 +206,31 @@ func (p *accountParams) WithNetworkConfig(config *Config) *accountParams {
 	return p
 }
 
+/**
+ * Derive the keys of the seed with another nonce than 0, so one seed covers several accounts
+ */
+func (p *accountParams) WithNonce(nonce int32) *accountParams {
+	p.nonce = nonce
+
+	return p
+}
+
 type Account struct {
 	/**
 	 * Client Wallet Address
 	 */
-	Address []byte
+	Address Address
 
 	/**
 	 * Seed phrase
 	 */
 	Seed []byte
 
+	/**
+	 * Nonce used to derive the keys from the seed
+	 */
+	Nonce int32
+
 	/**
 	 * Signing keys
 	 */
@@ -202,20 +304,15 @@ func (a *Account) GetRandomNonce() ([]byte, error) {
 	return bytes, nil
 }
 
-func generateNewSeed(length int) ([]byte, error) {
-	dictionarySize := len(seedDictionary)
-	words := make([]string, length)
-
-	for i := 0; i < length; i++ {
-		randomBigInt, err := cryptorand.Int(Rand, big.NewInt(int64(dictionarySize)))
-		if err != nil {
-			return nil, err
-		}
-
-		idx := int(randomBigInt.Int64()) % dictionarySize
+func generateNewSeed(words int, wordlist Wordlist) ([]byte, error) {
+	if words < 12 || words > 24 || words%3 != 0 {
+		return nil, errors.Errorf("mnemonic must have 12, 15, 18, 21 or 24 words, got %d", words)
+	}
 
-		words[i] = seedDictionary[idx]
+	mnemonic, err := GenerateMnemonic(words/3*32, wordlist)
+	if err != nil {
+		return nil, err
 	}
 
-	return []byte(strings.Join(words, " ")), nil
+	return []byte(normalizeMnemonic(mnemonic)), nil
 }
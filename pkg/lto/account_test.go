@@ -1,6 +1,7 @@
 package lto_test
 
 import (
+	"math"
 	"reflect"
 	"testing"
 	"time"
@@ -333,3 +334,84 @@ func TestAccount_CreateEventChain(t *testing.T) {
 		})
 	}
 }
+
+func TestAccount_FromMnemonic(t *testing.T) {
+	mnemonic := "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
+	account, err := lto.NewAccount().FromMnemonic(mnemonic).Create()
+	require.NoError(t, err)
+
+	fromSeed, err := lto.NewAccount().FromSeed([]byte(mnemonic)).Create()
+	require.NoError(t, err)
+	require.Equal(t, fromSeed.Address, account.Address)
+
+	_, err = lto.NewAccount().FromMnemonic("legal winner thank year wave sausage wroth useful legal winner thank yellow").Create()
+	require.EqualError(t, err, `unknown word "wroth" at position 7`)
+}
+
+func TestAccount_FromRandomN(t *testing.T) {
+	account, err := lto.NewAccount().FromRandomN(24).WithWordlist(lto.WordlistSpanish).Create()
+	require.NoError(t, err)
+	require.NoError(t, lto.ValidateMnemonic(string(account.Seed)))
+	require.Equal(t, lto.WordlistSpanish, lto.DetectWordlist(string(account.Seed)))
+
+	_, err = lto.NewAccount().FromRandomN(13).Create()
+	require.Error(t, err)
+}
+
+func TestAccount_WithNonce(t *testing.T) {
+	seed := []byte("manage manual recall harvest series desert melt police rose hollow moral pledge kitten position add")
+
+	first, err := lto.NewAccount().FromSeed(seed).Create()
+	require.NoError(t, err)
+
+	zero, err := lto.NewAccount().FromSeed(seed).WithNonce(0).Create()
+	require.NoError(t, err)
+	require.Equal(t, first.Address, zero.Address)
+
+	second, err := lto.NewAccount().FromSeed(seed).WithNonce(1).Create()
+	require.NoError(t, err)
+	require.NotEqual(t, first.Address, second.Address)
+	require.Equal(t, int32(1), second.Nonce)
+	require.True(t, second.Address.IsValid())
+
+	again, err := lto.NewAccount().FromSeed(seed).WithNonce(1).Create()
+	require.NoError(t, err)
+	require.Equal(t, second.Sign, again.Sign)
+
+	_, err = lto.NewAccount().FromPrivateKey(first.Sign.PrivateKey).WithNonce(1).Create()
+	require.Error(t, err)
+}
+
+func TestAccount_CreateRange(t *testing.T) {
+	seed := []byte("manage manual recall harvest series desert melt police rose hollow moral pledge kitten position add")
+
+	accounts, err := lto.NewAccount().FromSeed(seed).WithNonce(2).CreateRange(3)
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+
+	for i, account := range accounts {
+		want, err := lto.NewAccount().FromSeed(seed).WithNonce(int32(2 + i)).Create()
+		require.NoError(t, err)
+		require.Equal(t, want.Address, account.Address)
+		require.Equal(t, int32(2+i), account.Nonce)
+	}
+
+	random, err := lto.NewAccount().CreateRange(2)
+	require.NoError(t, err)
+	require.Equal(t, random[0].Seed, random[1].Seed)
+	require.NotEqual(t, random[0].Address, random[1].Address)
+
+	_, err = lto.NewAccount().FromPrivateKey(accounts[0].Sign.PrivateKey).CreateRange(2)
+	require.Error(t, err)
+
+	_, err = lto.NewAccount().FromSeed(seed).CreateRange(-1)
+	require.Error(t, err)
+
+	_, err = lto.NewAccount().FromSeed(seed).WithNonce(math.MaxInt32 - 1).CreateRange(3)
+	require.Error(t, err)
+
+	last, err := lto.NewAccount().FromSeed(seed).WithNonce(math.MaxInt32 - 1).CreateRange(2)
+	require.NoError(t, err)
+	require.Equal(t, int32(math.MaxInt32), last[1].Nonce)
+}
@@ -0,0 +1,110 @@
+package lto
+
+import (
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+/**
+ * Raw address of an account, it is base58 encoded as text and JSON
+ */
+type Address []byte
+
+/**
+ * Decode a base58 address and check its checksum
+ */
+func ParseAddress(s string) (Address, error) {
+	b, err := crypto.Base58DecodeStrict(s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid address %s", s)
+	}
+
+	address := Address(b)
+
+	err = address.Validate()
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid address %s", s)
+	}
+
+	return address, nil
+}
+
+/**
+ * Decode a base58 address and check that it belongs to the network
+ */
+func ParseNetworkAddress(s string, network Network) (Address, error) {
+	address, err := ParseAddress(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if address.Network() != network {
+		return nil, errors.Errorf("address %s is not for network %c", s, network)
+	}
+
+	return address, nil
+}
+
+/**
+ * Create the address of a public key on the network
+ */
+func NewAddress(publicKey []byte, network Network) Address {
+	return Address(crypto.BuildRawAddress(publicKey, byte(network)))
+}
+
+/**
+ * Check the length, version and checksum of the address
+ */
+func (a Address) Validate() error {
+	if len(a) != crypto.AddressLength {
+		return errors.New("wrong length")
+	}
+
+	if !crypto.IsValidAddress(a, a[1]) {
+		return errors.New("wrong version or checksum")
+	}
+
+	return nil
+}
+
+func (a Address) IsValid() bool {
+	return a.Validate() == nil
+}
+
+/**
+ * Network the address belongs to, 0 for an invalid address
+ */
+func (a Address) Network() Network {
+	if len(a) != crypto.AddressLength {
+		return 0
+	}
+
+	return Network(a[1])
+}
+
+func (a Address) String() string {
+	return crypto.Base58Encode(a)
+}
+
+func (a Address) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+/**
+ * Decode a base58 address, an empty text gives an empty address
+ */
+func (a *Address) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*a = nil
+		return nil
+	}
+
+	address, err := ParseAddress(string(text))
+	if err != nil {
+		return err
+	}
+
+	*a = address
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package lto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		network lto.Network
+		wantErr bool
+	}{
+		{
+			name:    "should parse a testnet address",
+			address: "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8",
+			network: lto.NetworkTest,
+		},
+		{
+			name:    "should parse a mainnet address",
+			address: "3JmCa4jLVv7Yn2XkCnBUGsa7WNFVEMxAfWe",
+			network: lto.NetworkMain,
+		},
+		{
+			name:    "should fail on a wrong checksum",
+			address: "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq9",
+			wantErr: true,
+		},
+		{
+			name:    "should fail on a wrong length",
+			address: "3N6mZMgGqYn9EVAR2Vbf637iej4fFipE",
+			wantErr: true,
+		},
+		{
+			name:    "should fail on an invalid base58 character",
+			address: "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq0",
+			wantErr: true,
+		},
+		{
+			name:    "should fail on an empty address",
+			address: "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lto.ParseAddress(tt.address)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.address, got.String())
+			require.Equal(t, tt.network, got.Network())
+			require.True(t, got.IsValid())
+		})
+	}
+}
+
+func TestParseNetworkAddress(t *testing.T) {
+	_, err := lto.ParseNetworkAddress("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8", lto.NetworkTest)
+	require.NoError(t, err)
+
+	_, err = lto.ParseNetworkAddress("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8", lto.NetworkMain)
+	require.Error(t, err)
+}
+
+func TestNewAddress(t *testing.T) {
+	publicKey := crypto.Base58Decode("FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y")
+
+	address := lto.NewAddress(publicKey, lto.NetworkTest)
+	require.True(t, address.IsValid())
+	require.Equal(t, lto.NetworkTest, address.Network())
+	require.Equal(t, crypto.Base58Encode(crypto.BuildRawAddress(publicKey, byte(lto.NetworkTest))), address.String())
+}
+
+func TestAddress_JSON(t *testing.T) {
+	type payload struct {
+		Address lto.Address `json:"address"`
+	}
+
+	address, err := lto.ParseAddress("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")
+	require.NoError(t, err)
+
+	data, err := json.Marshal(&payload{Address: address})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"address":"3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8"}`, string(data))
+
+	var got payload
+	require.NoError(t, json.Unmarshal(data, &got))
+	require.Equal(t, address, got.Address)
+
+	require.Error(t, json.Unmarshal([]byte(`{"address":"3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq9"}`), &got))
+
+	require.NoError(t, json.Unmarshal([]byte(`{"address":""}`), &got))
+	require.Nil(t, got.Address)
+}
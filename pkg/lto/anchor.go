@@ -0,0 +1,214 @@
+package lto
+
+import (
+	"encoding/json"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+const AnchorBaseFee int64 = 25000000
+const AnchorVarFee int64 = 10000000
+const AnchorDefaultVersion byte = 3
+const MaxAnchors = 100
+
+type anchorParams struct {
+	version   byte
+	network   Network
+	anchors   [][]byte
+	fee       int64
+	timestamp int64
+	fees      *FeeCalculator
+}
+
+func NewAnchor() *anchorParams {
+	return &anchorParams{
+		version:   AnchorDefaultVersion,
+		timestamp: getTimestamp(),
+	}
+}
+
+func (p *anchorParams) Create() (*Anchor, error) {
+	if p.version != 1 && p.version != 3 {
+		return nil, errors.Errorf("unsupported anchor version %d", p.version)
+	}
+
+	if len(p.anchors) == 0 {
+		return nil, errors.New("no anchors set")
+	}
+
+	if len(p.anchors) > MaxAnchors {
+		return nil, errors.Errorf("an anchor transaction can hold at most %d anchors", MaxAnchors)
+	}
+
+	for _, anchor := range p.anchors {
+		if len(anchor) != 32 && len(anchor) != 64 {
+			return nil, errors.New("anchor must be a hash of 32 or 64 bytes")
+		}
+	}
+
+	tx := &Anchor{
+		TransactionBase: TransactionBase{
+			Type:      TransactionTypeAnchor,
+			Version:   p.version,
+			Network:   p.network,
+			Fee:       p.fee,
+			Timestamp: p.timestamp,
+		},
+		Anchors: p.anchors,
+	}
+
+	err := fillFee(tx, p.fees)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (p *anchorParams) WithAnchors(anchors ...[]byte) *anchorParams {
+	p.anchors = append(p.anchors, anchors...)
+	return p
+}
+
+func (p *anchorParams) WithFee(fee int64) *anchorParams {
+	p.fee = fee
+	return p
+}
+
+func (p *anchorParams) WithTimestamp(timestamp int64) *anchorParams {
+	p.timestamp = timestamp
+	return p
+}
+
+func (p *anchorParams) WithVersion(version byte) *anchorParams {
+	p.version = version
+	return p
+}
+
+func (p *anchorParams) WithNetwork(network Network) *anchorParams {
+	p.network = network
+	return p
+}
+
+func (p *anchorParams) WithNetworkConfig(config *Config) *anchorParams {
+	p.network = config.Network
+	p.fees = NewFeeCalculator(config.BasicConfig)
+	return p
+}
+
+type Anchor struct {
+	TransactionBase
+
+	/**
+	 * Hashes anchored on the public chain
+	 */
+	Anchors [][]byte
+}
+
+func (t *Anchor) GetBodyBytes() ([]byte, error) {
+	if len(t.SenderPublicKey) == 0 {
+		return nil, errors.New("first set sender before creating body bytes")
+	}
+
+	var values []interface{}
+
+	switch t.Version {
+	case 1:
+		values = []interface{}{
+			t.Type,
+			t.Version,
+			t.SenderPublicKey,
+		}
+		values = append(values, anchorValues(t.Anchors)...)
+		values = append(values, t.Timestamp, t.Fee)
+	case 3:
+		if t.Network == 0 {
+			return nil, errors.New("network unknown")
+		}
+
+		values = []interface{}{
+			t.Type,
+			t.Version,
+			t.Network,
+			t.Timestamp,
+			t.SenderKeyType,
+			t.SenderPublicKey,
+			t.Fee,
+		}
+		values = append(values, anchorValues(t.Anchors)...)
+	default:
+		return nil, errors.Errorf("unsupported anchor version %d", t.Version)
+	}
+
+	return writeBinary(values...)
+}
+
+func anchorValues(anchors [][]byte) []interface{} {
+	values := []interface{}{uint16(len(anchors))}
+
+	for _, anchor := range anchors {
+		values = append(values, uint16(len(anchor)), anchor)
+	}
+
+	return values
+}
+
+func (t *Anchor) SignWith(account *Account) (*Anchor, error) {
+	err := account.SignTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *Anchor) SponsorWith(account *Account) (*Anchor, error) {
+	err := account.SponsorTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type anchorJSON struct {
+	transactionBaseJSON
+	Anchors []string `json:"anchors"`
+}
+
+func (t *Anchor) MarshalJSON() ([]byte, error) {
+	anchors := make([]string, len(t.Anchors))
+	for i, anchor := range t.Anchors {
+		anchors[i] = crypto.Base58Encode(anchor)
+	}
+
+	return json.Marshal(&anchorJSON{
+		transactionBaseJSON: t.toJSON(),
+		Anchors:             anchors,
+	})
+}
+
+func (t *Anchor) UnmarshalJSON(data []byte) error {
+	res := new(anchorJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	err = t.fromJSON(&res.transactionBaseJSON)
+	if err != nil {
+		return err
+	}
+
+	t.Anchors = make([][]byte, len(res.Anchors))
+	for i, anchor := range res.Anchors {
+		t.Anchors[i], err = decodeBase58("anchor", anchor)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
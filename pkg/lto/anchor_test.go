@@ -0,0 +1,138 @@
+package lto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestAnchor_GetBodyBytes(t *testing.T) {
+	publicKey := crypto.Base58Decode("FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y")
+	hash := crypto.Sha256([]byte("hello"))
+
+	type fields struct {
+		version byte
+		anchors [][]byte
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantFee int64
+		wantErr bool
+	}{
+		{
+			name: "should serialize a v1 anchor",
+			fields: fields{
+				version: 1,
+				anchors: [][]byte{hash},
+			},
+			want: concat(
+				[]byte{15, 1},
+				publicKey,
+				[]byte{0, 1, 0, 32},
+				hash,
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{0, 0, 0, 0, 0x02, 0x16, 0x0e, 0xc0},
+			),
+			wantFee: 35000000,
+		},
+		{
+			name: "should serialize a v3 anchor with multiple hashes",
+			fields: fields{
+				version: 3,
+				anchors: [][]byte{hash, hash},
+			},
+			want: concat(
+				[]byte{15, 3, 'T'},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{1},
+				publicKey,
+				[]byte{0, 0, 0, 0, 0x02, 0xae, 0xa5, 0x40},
+				[]byte{0, 2, 0, 32},
+				hash,
+				[]byte{0, 32},
+				hash,
+			),
+			wantFee: 45000000,
+		},
+		{
+			name: "should throw an error for an invalid hash length",
+			fields: fields{
+				version: 3,
+				anchors: [][]byte{[]byte("foo")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should throw an error when no anchors are set",
+			fields: fields{
+				version: 3,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := lto.NewAccount().
+				FromPrivateKey(crypto.Base58Decode("wJ4WH8dD88fSkNdFQRjaAhjFUZzZhV5yiDLDwNUnp6bYwRXrvWV8MJhQ9HL9uqMDG1n7XpTGZx7PafqaayQV8Rp")).
+				WithNetwork(lto.NetworkTest).
+				Create()
+			require.NoError(t, err)
+
+			tx, err := lto.NewAnchor().
+				WithVersion(tt.fields.version).
+				WithAnchors(tt.fields.anchors...).
+				WithTimestamp(1519862400000).
+				Create()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantFee, tx.Fee)
+
+			tx, err = tx.SignWith(a)
+			require.NoError(t, err)
+
+			body, err := tx.GetBodyBytes()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, body)
+
+			valid, err := a.Verify(tx.Proofs[0], body)
+			require.NoError(t, err)
+			require.True(t, valid)
+		})
+	}
+}
+
+func TestAnchor_JSON(t *testing.T) {
+	a, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	hash := crypto.Sha256([]byte("hello"))
+
+	tx, err := lto.NewAnchor().WithAnchors(hash).Create()
+	require.NoError(t, err)
+
+	tx, err = tx.SignWith(a)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(tx)
+	require.NoError(t, err)
+
+	var raw map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &raw))
+	require.Equal(t, []interface{}{crypto.Base58Encode(hash)}, raw["anchors"])
+
+	got := new(lto.Anchor)
+	require.NoError(t, json.Unmarshal(data, got))
+	require.Equal(t, tx.ID, got.ID)
+	require.Equal(t, tx.Anchors, got.Anchors)
+	require.Equal(t, tx.Proofs, got.Proofs)
+}
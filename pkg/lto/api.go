@@ -1,12 +1,42 @@
 package lto
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
 	"github.com/go-resty/resty/v2"
+	"github.com/pkg/errors"
 )
 
 func NewAPI(config *Config) (*API, error) {
+	pool, err := newNodePool(config.nodeAddresses(), config.NodeSelection, config.NodeCooldown)
+	if err != nil {
+		return nil, err
+	}
+
 	client := resty.New()
-	client.SetHostURL(config.NodeAddress)
+	client.SetHostURL(pool.nodes[0].address)
+
+	transport := client.GetClient().Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	client.SetTransport(&nodeTransport{
+		pool:               pool,
+		transport:          transport,
+		hostURL:            pool.nodes[0].address,
+		retryCount:         config.RetryCount,
+		retryWaitTime:      config.RetryWaitTime,
+		retryMaxWaitTime:   config.RetryMaxWaitTime,
+		healthCheckTimeout: DefaultHealthCheckTimeout,
+	})
+
+	if config.Timeout > 0 {
+		client.SetTimeout(config.Timeout)
+	}
 
 	return &API{
 		client: client,
@@ -18,3 +48,126 @@ type API struct {
 	client *resty.Client
 	config *Config
 }
+
+/**
+ * Error codes of the node
+ */
+const (
+	APIErrorInvalidSignature        = 101
+	APIErrorInvalidSender           = 106
+	APIErrorStateCheckFailed        = 112
+	APIErrorCustomValidation        = 199
+	APIErrorBlockDoesNotExist       = 301
+	APIErrorTransactionDoesNotExist = 311
+)
+
+/**
+ * Error response of the node
+ */
+type APIError struct {
+	StatusCode int
+
+	/**
+	 * Error code of the node, 0 if the response has no error code
+	 */
+	Code    int
+	Message string
+	Path    string
+
+	/**
+	 * The rejected transaction, only set when broadcasting fails
+	 */
+	Transaction json.RawMessage
+}
+
+type apiErrorJSON struct {
+	Code        int             `json:"error"`
+	Message     string          `json:"message"`
+	Transaction json.RawMessage `json:"tx,omitempty"`
+}
+
+func newAPIError(path string, r *resty.Response) *APIError {
+	e := &APIError{
+		StatusCode: r.StatusCode(),
+		Path:       path,
+	}
+
+	res := new(apiErrorJSON)
+	if json.Unmarshal(r.Body(), res) == nil && res.Message != "" {
+		e.Code = res.Code
+		e.Message = res.Message
+		e.Transaction = res.Transaction
+		return e
+	}
+
+	e.Message = strings.TrimSpace(string(r.Body()))
+	if e.Message == "" {
+		e.Message = http.StatusText(e.StatusCode)
+	}
+
+	return e
+}
+
+func (e *APIError) Error() string {
+	if e.Code == 0 {
+		return fmt.Sprintf("%s (status %d)", e.Message, e.StatusCode)
+	}
+
+	return fmt.Sprintf("%s (error %d)", e.Message, e.Code)
+}
+
+func (e *APIError) hasMessage(parts ...string) bool {
+	message := strings.ToLower(e.Message)
+
+	for _, part := range parts {
+		if strings.Contains(message, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toAPIError(err error) (*APIError, bool) {
+	e, ok := errors.Cause(err).(*APIError)
+	return e, ok
+}
+
+/**
+ * Check if the node doesn't know the requested transaction, block or other resource
+ */
+func IsNotFound(err error) bool {
+	e, ok := toAPIError(err)
+
+	return ok && (e.StatusCode == http.StatusNotFound ||
+		e.Code == APIErrorBlockDoesNotExist ||
+		e.Code == APIErrorTransactionDoesNotExist)
+}
+
+/**
+ * Check if the node rejected a transaction because a signature or proof is invalid
+ */
+func IsInvalidSignature(err error) bool {
+	e, ok := toAPIError(err)
+
+	return ok && (e.Code == APIErrorInvalidSignature ||
+		e.hasMessage("invalid signature", "proof doesn't validate", "invalid proof"))
+}
+
+/**
+ * Check if the node rejected a transaction because the balance of the sender or sponsor is too low
+ */
+func IsInsufficientBalance(err error) bool {
+	e, ok := toAPIError(err)
+
+	return ok && e.hasMessage("negative lto balance", "negative balance", "unavailable funds", "insufficient")
+}
+
+/**
+ * Check if the node rejected a transaction because it is already in the unconfirmed pool
+ */
+func IsAlreadyInUTX(err error) bool {
+	e, ok := toAPIError(err)
+
+	return ok && e.hasMessage("already in the pool", "already in utx", "already in the utx")
+}
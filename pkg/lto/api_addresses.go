@@ -1,101 +1,212 @@
 package lto
 
 import (
+	"context"
 	"fmt"
+	"net/url"
 
-	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
 	"github.com/pkg/errors"
 )
 
 type balanceResponse struct {
-	Address       string `json:"address"`
-	Confirmations int64  `json:"confirmations"`
-	Balance       int64  `json:"balance"`
+	Address       Address `json:"address"`
+	Confirmations int64   `json:"confirmations"`
+	Balance       int64   `json:"balance"`
 }
 
 type BalanceResponse struct {
-	Address       []byte `json:"address"`
-	Confirmations int64  `json:"confirmations"`
-	Balance       int64  `json:"balance"`
+	Address       Address `json:"address"`
+	Confirmations int64   `json:"confirmations"`
+	Balance       int64   `json:"balance"`
 }
 
-func (api *API) AddressBalance(address []byte) (*BalanceResponse, error) {
-	addressString := crypto.Base58Encode(address)
+func (api *API) AddressBalance(address Address) (*BalanceResponse, error) {
+	return api.AddressBalanceContext(context.Background(), address)
+}
+
+func (api *API) AddressBalanceContext(ctx context.Context, address Address) (*BalanceResponse, error) {
 	res := new(balanceResponse)
 
-	path := fmt.Sprintf("/addresses/balance/%s", addressString)
-	r, err := api.client.R().SetResult(res).Get(path)
+	path := fmt.Sprintf("/addresses/balance/%s", address)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get balance")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
 	}
 
 	return &BalanceResponse{
-		Address:       crypto.Base58Decode(res.Address),
+		Address:       res.Address,
 		Confirmations: res.Confirmations,
 		Balance:       res.Balance,
 	}, nil
 }
 
-func (api *API) AddressBalanceWithConfirmations(address []byte, confirmations int) (*BalanceResponse, error) {
-	addressString := crypto.Base58Encode(address)
+func (api *API) AddressBalanceWithConfirmations(address Address, confirmations int) (*BalanceResponse, error) {
+	return api.AddressBalanceWithConfirmationsContext(context.Background(), address, confirmations)
+}
+
+func (api *API) AddressBalanceWithConfirmationsContext(ctx context.Context, address Address, confirmations int) (*BalanceResponse, error) {
 	res := new(balanceResponse)
 
-	path := fmt.Sprintf("/addresses/balance/%s/%d", addressString, confirmations)
-	r, err := api.client.R().SetResult(res).Get(path)
+	path := fmt.Sprintf("/addresses/balance/%s/%d", address, confirmations)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get balance")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
 	}
 
 	return &BalanceResponse{
-		Address:       crypto.Base58Decode(res.Address),
+		Address:       res.Address,
 		Confirmations: res.Confirmations,
 		Balance:       res.Balance,
 	}, nil
 }
 
 type balanceDetailsResponse struct {
-	Address    string `json:"address"`
-	Regular    int64  `json:"regular"`
-	Generating int64  `json:"generating"`
-	Available  int64  `json:"available"`
-	Effective  int64  `json:"effective"`
+	Address    Address `json:"address"`
+	Regular    int64   `json:"regular"`
+	Generating int64   `json:"generating"`
+	Available  int64   `json:"available"`
+	Effective  int64   `json:"effective"`
 }
 
 type BalanceDetailsResponse struct {
-	Address    []byte `json:"address"`
-	Regular    int64  `json:"regular"`
-	Generating int64  `json:"generating"`
-	Available  int64  `json:"available"`
-	Effective  int64  `json:"effective"`
+	Address    Address `json:"address"`
+	Regular    int64   `json:"regular"`
+	Generating int64   `json:"generating"`
+	Available  int64   `json:"available"`
+	Effective  int64   `json:"effective"`
 }
 
-func (api *API) AddressBalanceDetails(address []byte) (*BalanceDetailsResponse, error) {
-	addressString := crypto.Base58Encode(address)
+func (api *API) AddressBalanceDetails(address Address) (*BalanceDetailsResponse, error) {
+	return api.AddressBalanceDetailsContext(context.Background(), address)
+}
+
+func (api *API) AddressBalanceDetailsContext(ctx context.Context, address Address) (*BalanceDetailsResponse, error) {
 	res := new(balanceDetailsResponse)
 
-	path := fmt.Sprintf("/addresses/balance/details/%s", addressString)
-	r, err := api.client.R().SetResult(res).Get(path)
+	path := fmt.Sprintf("/addresses/balance/details/%s", address)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get balance details")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
 	}
 
 	return &BalanceDetailsResponse{
-		Address:    crypto.Base58Decode(res.Address),
+		Address:    res.Address,
 		Regular:    res.Regular,
 		Generating: res.Generating,
 		Available:  res.Available,
 		Effective:  res.Effective,
 	}, nil
 }
+
+func (api *API) AddressData(address Address) ([]*DataEntry, error) {
+	return api.AddressDataContext(context.Background(), address)
+}
+
+func (api *API) AddressDataContext(ctx context.Context, address Address) ([]*DataEntry, error) {
+	var res []*DataEntry
+
+	path := fmt.Sprintf("/addresses/data/%s", address)
+	r, err := api.client.R().SetContext(ctx).SetResult(&res).Get(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get data")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
+	}
+
+	return res, nil
+}
+
+func (api *API) AddressDataByKey(address Address, key string) (*DataEntry, error) {
+	return api.AddressDataByKeyContext(context.Background(), address, key)
+}
+
+func (api *API) AddressDataByKeyContext(ctx context.Context, address Address, key string) (*DataEntry, error) {
+	res := new(DataEntry)
+
+	path := fmt.Sprintf("/addresses/data/%s/%s", address, url.PathEscape(key))
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get data")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
+	}
+
+	return res, nil
+}
+
+type scriptInfoResponse struct {
+	Address    Address `json:"address"`
+	Script     *string `json:"script"`
+	ScriptText *string `json:"scriptText"`
+	Complexity int64   `json:"complexity"`
+	ExtraFee   int64   `json:"extraFee"`
+}
+
+type ScriptInfoResponse struct {
+	Address Address
+
+	/**
+	 * Compiled script, nil if the account has no script
+	 */
+	Script     []byte
+	ScriptText string
+	Complexity int64
+
+	/**
+	 * Fee added to every transaction of the account
+	 */
+	ExtraFee int64
+}
+
+func (api *API) AddressScriptInfo(address Address) (*ScriptInfoResponse, error) {
+	return api.AddressScriptInfoContext(context.Background(), address)
+}
+
+func (api *API) AddressScriptInfoContext(ctx context.Context, address Address) (*ScriptInfoResponse, error) {
+	res := new(scriptInfoResponse)
+
+	path := fmt.Sprintf("/addresses/scriptInfo/%s", address)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get script info")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
+	}
+
+	info := &ScriptInfoResponse{
+		Address:    res.Address,
+		Complexity: res.Complexity,
+		ExtraFee:   res.ExtraFee,
+	}
+
+	if res.Script != nil {
+		info.Script, err = decodeScript(*res.Script)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if res.ScriptText != nil {
+		info.ScriptText = *res.ScriptText
+	}
+
+	return info, nil
+}
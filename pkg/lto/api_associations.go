@@ -0,0 +1,114 @@
+package lto
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+type associationStatusResponse struct {
+	AssociationType     int32   `json:"associationType"`
+	Party               Address `json:"party"`
+	Hash                string  `json:"hash"`
+	Timestamp           int64   `json:"timestamp"`
+	Expires             int64   `json:"expires"`
+	TransactionID       string  `json:"transactionId"`
+	Height              int64   `json:"height"`
+	RevokeTransactionID string  `json:"revokeTransactionId"`
+	RevokeTimestamp     int64   `json:"revokeTimestamp"`
+	RevokeHeight        int64   `json:"revokeHeight"`
+}
+
+type associationsStatusResponse struct {
+	Address              Address                      `json:"address"`
+	OutgoingAssociations []*associationStatusResponse `json:"outgoingAssociations"`
+	IncomingAssociations []*associationStatusResponse `json:"incomingAssociations"`
+}
+
+type AssociationStatus struct {
+	AssociationType int32
+	Party           Address
+	Hash            []byte
+	Timestamp       int64
+	Expires         int64
+	TransactionID   string
+	Height          int64
+
+	/**
+	 * Set when the association has been revoked
+	 */
+	RevokeTransactionID string
+	RevokeTimestamp     int64
+	RevokeHeight        int64
+}
+
+func (s *AssociationStatus) IsRevoked() bool {
+	return s.RevokeTransactionID != ""
+}
+
+type AssociationsStatusResponse struct {
+	Address              Address
+	OutgoingAssociations []*AssociationStatus
+	IncomingAssociations []*AssociationStatus
+}
+
+func (api *API) AssociationsStatus(address Address) (*AssociationsStatusResponse, error) {
+	return api.AssociationsStatusContext(context.Background(), address)
+}
+
+func (api *API) AssociationsStatusContext(ctx context.Context, address Address) (*AssociationsStatusResponse, error) {
+	res := new(associationsStatusResponse)
+
+	path := fmt.Sprintf("/associations/status/%s", address)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get associations")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
+	}
+
+	outgoing, err := newAssociationStatusList(res.OutgoingAssociations)
+	if err != nil {
+		return nil, err
+	}
+
+	incoming, err := newAssociationStatusList(res.IncomingAssociations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AssociationsStatusResponse{
+		Address:              res.Address,
+		OutgoingAssociations: outgoing,
+		IncomingAssociations: incoming,
+	}, nil
+}
+
+func newAssociationStatusList(list []*associationStatusResponse) ([]*AssociationStatus, error) {
+	res := make([]*AssociationStatus, len(list))
+
+	for i, item := range list {
+		hash, err := decodeBase58("hash", item.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = &AssociationStatus{
+			AssociationType:     item.AssociationType,
+			Party:               item.Party,
+			Hash:                hash,
+			Timestamp:           item.Timestamp,
+			Expires:             item.Expires,
+			TransactionID:       item.TransactionID,
+			Height:              item.Height,
+			RevokeTransactionID: item.RevokeTransactionID,
+			RevokeTimestamp:     item.RevokeTimestamp,
+			RevokeHeight:        item.RevokeHeight,
+		}
+	}
+
+	return res, nil
+}
@@ -1,23 +1,33 @@
 package lto
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/pkg/errors"
 )
 
+/**
+ * Block without its transactions
+ */
+type BlockHeader struct {
+	Version          int64         `json:"version"`
+	Timestamp        int64         `json:"timestamp"`
+	Reference        string        `json:"reference"`
+	NXTConsensus     *NXTConsensus `json:"nxt-consensus"`
+	Generator        string        `json:"generator"`
+	Signature        string        `json:"signature"`
+	BlockSize        int64         `json:"blocksize"`
+	TransactionCount int64         `json:"transactionCount"`
+	Fee              int64         `json:"fee"`
+	Height           int64         `json:"height"`
+}
+
 type BlocksGetResponse struct {
-	Version          int64           `json:"version"`
-	Timestamp        int64           `json:"timestamp"`
-	Reference        string          `json:"reference"`
-	NXTConsensus     *NXTConsensus   `json:"nxt-consensus"`
-	Generator        string          `json:"generator"`
-	Signature        string          `json:"signature"`
-	BlockSize        int64           `json:"blocksize"`
-	TransactionCount int64           `json:"transactionCount"`
-	Fee              int64           `json:"fee"`
-	Transactions     []*Transactions `json:"transactions"`
-	Height           int64           `json:"height"`
+	BlockHeader
+
+	Transactions []Transaction `json:"transactions"`
 }
 
 type NXTConsensus struct {
@@ -25,75 +35,100 @@ type NXTConsensus struct {
 	GenerationSignature string `json:"generation-signature"`
 }
 
-type Transactions struct {
-	Type      int64  `json:"type"`
-	ID        string `json:"id"`
-	Fee       int64  `json:"fee"`
-	Timestamp int64  `json:"timestamp"`
-	Signature string `json:"signature"`
-	Recipient string `json:"recipient"`
-	Amount    int64  `json:"amount"`
+func (b *BlocksGetResponse) UnmarshalJSON(data []byte) error {
+	type block BlocksGetResponse
+
+	res := new(struct {
+		*block
+		Transactions []json.RawMessage `json:"transactions"`
+	})
+	res.block = (*block)(b)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	b.Transactions, err = decodeTransactionList(res.Transactions)
+
+	return err
 }
 
 func (api *API) BlocksGet(signature string) (*BlocksGetResponse, error) {
+	return api.BlocksGetContext(context.Background(), signature)
+}
+
+func (api *API) BlocksGetContext(ctx context.Context, signature string) (*BlocksGetResponse, error) {
 	res := new(BlocksGetResponse)
 
 	path := fmt.Sprintf("/blocks/signature/%s", signature)
-	r, err := api.client.R().SetResult(res).Get(path)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get block")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
 	}
 
 	return res, nil
 }
 
 func (api *API) BlocksAt(height int64) (*BlocksGetResponse, error) {
+	return api.BlocksAtContext(context.Background(), height)
+}
+
+func (api *API) BlocksAtContext(ctx context.Context, height int64) (*BlocksGetResponse, error) {
 	res := new(BlocksGetResponse)
 
 	path := fmt.Sprintf("/blocks/at/%d", height)
-	r, err := api.client.R().SetResult(res).Get(path)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get block")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
 	}
 
 	return res, nil
 }
 
 func (api *API) BlocksFirst() (*BlocksGetResponse, error) {
+	return api.BlocksFirstContext(context.Background())
+}
+
+func (api *API) BlocksFirstContext(ctx context.Context) (*BlocksGetResponse, error) {
 	res := new(BlocksGetResponse)
 
 	path := fmt.Sprintf("/blocks/first")
-	r, err := api.client.R().SetResult(res).Get(path)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get block")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
 	}
 
 	return res, nil
 }
 
 func (api *API) BlocksLast() (*BlocksGetResponse, error) {
+	return api.BlocksLastContext(context.Background())
+}
+
+func (api *API) BlocksLastContext(ctx context.Context) (*BlocksGetResponse, error) {
 	res := new(BlocksGetResponse)
 
 	path := fmt.Sprintf("/blocks/last")
-	r, err := api.client.R().SetResult(res).Get(path)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get block")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
 	}
 
 	return res, nil
@@ -104,16 +139,185 @@ type BlocksHeightResponse struct {
 }
 
 func (api *API) BlocksHeight() (*BlocksHeightResponse, error) {
+	return api.BlocksHeightContext(context.Background())
+}
+
+func (api *API) BlocksHeightContext(ctx context.Context) (*BlocksHeightResponse, error) {
 	res := new(BlocksHeightResponse)
 
 	path := fmt.Sprintf("/blocks/height")
-	r, err := api.client.R().SetResult(res).Get(path)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get block")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
+	}
+
+	return res, nil
+}
+
+/**
+ * Blocks from height from up to and including height to, the node limits the number of blocks per request
+ */
+func (api *API) BlocksSeq(from int64, to int64) ([]*BlocksGetResponse, error) {
+	return api.BlocksSeqContext(context.Background(), from, to)
+}
+
+func (api *API) BlocksSeqContext(ctx context.Context, from int64, to int64) ([]*BlocksGetResponse, error) {
+	var res []*BlocksGetResponse
+
+	path := fmt.Sprintf("/blocks/seq/%d/%d", from, to)
+	r, err := api.client.R().SetContext(ctx).SetResult(&res).Get(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get block")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
+	}
+
+	return res, nil
+}
+
+/**
+ * Headers of the blocks from height from up to and including height to
+ */
+func (api *API) BlocksHeadersSeq(from int64, to int64) ([]*BlockHeader, error) {
+	return api.BlocksHeadersSeqContext(context.Background(), from, to)
+}
+
+func (api *API) BlocksHeadersSeqContext(ctx context.Context, from int64, to int64) ([]*BlockHeader, error) {
+	var res []*BlockHeader
+
+	path := fmt.Sprintf("/blocks/headers/seq/%d/%d", from, to)
+	r, err := api.client.R().SetContext(ctx).SetResult(&res).Get(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get block")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
+	}
+
+	return res, nil
+}
+
+func (api *API) BlocksHeadersLast() (*BlockHeader, error) {
+	return api.BlocksHeadersLastContext(context.Background())
+}
+
+func (api *API) BlocksHeadersLastContext(ctx context.Context) (*BlockHeader, error) {
+	res := new(BlockHeader)
+
+	path := fmt.Sprintf("/blocks/headers/last")
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get block")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
+	}
+
+	return res, nil
+}
+
+/**
+ * Blocks generated by the address from height from up to and including height to
+ */
+func (api *API) BlocksAddress(address Address, from int64, to int64) ([]*BlocksGetResponse, error) {
+	return api.BlocksAddressContext(context.Background(), address, from, to)
+}
+
+func (api *API) BlocksAddressContext(ctx context.Context, address Address, from int64, to int64) ([]*BlocksGetResponse, error) {
+	var res []*BlocksGetResponse
+
+	path := fmt.Sprintf("/blocks/address/%s/%d/%d", address, from, to)
+	r, err := api.client.R().SetContext(ctx).SetResult(&res).Get(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get block")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
+	}
+
+	return res, nil
+}
+
+/**
+ * Block following the block with the signature
+ */
+func (api *API) BlocksChild(signature string) (*BlocksGetResponse, error) {
+	return api.BlocksChildContext(context.Background(), signature)
+}
+
+func (api *API) BlocksChildContext(ctx context.Context, signature string) (*BlocksGetResponse, error) {
+	res := new(BlocksGetResponse)
+
+	path := fmt.Sprintf("/blocks/child/%s", signature)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get block")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
+	}
+
+	return res, nil
+}
+
+type BlocksDelayResponse struct {
+	/**
+	 * Average delay between blocks in milliseconds
+	 */
+	Delay int64 `json:"delay"`
+}
+
+/**
+ * Average delay of the blockNum blocks before the block with the signature
+ */
+func (api *API) BlocksDelay(signature string, blockNum int64) (*BlocksDelayResponse, error) {
+	return api.BlocksDelayContext(context.Background(), signature, blockNum)
+}
+
+func (api *API) BlocksDelayContext(ctx context.Context, signature string, blockNum int64) (*BlocksDelayResponse, error) {
+	res := new(BlocksDelayResponse)
+
+	path := fmt.Sprintf("/blocks/delay/%s/%d", signature, blockNum)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get block")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
+	}
+
+	return res, nil
+}
+
+/**
+ * Height of the block with the signature
+ */
+func (api *API) BlocksHeightBySignature(signature string) (*BlocksHeightResponse, error) {
+	return api.BlocksHeightBySignatureContext(context.Background(), signature)
+}
+
+func (api *API) BlocksHeightBySignatureContext(ctx context.Context, signature string) (*BlocksHeightResponse, error) {
+	res := new(BlocksHeightResponse)
+
+	path := fmt.Sprintf("/blocks/height/%s", signature)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get block")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
 	}
 
 	return res, nil
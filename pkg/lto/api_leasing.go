@@ -0,0 +1,28 @@
+package lto
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+func (api *API) LeasingActive(address Address) ([]*Lease, error) {
+	return api.LeasingActiveContext(context.Background(), address)
+}
+
+func (api *API) LeasingActiveContext(ctx context.Context, address Address) ([]*Lease, error) {
+	var res []*Lease
+
+	path := fmt.Sprintf("/leasing/active/%s", address)
+	r, err := api.client.R().SetContext(ctx).SetResult(&res).Get(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get active leases")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,215 @@
+package lto_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+	"github.com/ltonetwork/lto-sdk.go/pkg/ltotest"
+)
+
+var testRecipient = lto.Address(crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8"))
+
+/**
+ * Fake node with a funded account that made a transfer, which is confirmed in block 2
+ */
+func newTestNode(t *testing.T) (*ltotest.Node, *lto.API, *lto.Account, *lto.Transfer) {
+	a, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	node, err := ltotest.NewNode().WithBalance(a.Address, 10*100000000).Create()
+	require.NoError(t, err)
+
+	api, err := lto.NewAPI(node.Config())
+	require.NoError(t, err)
+
+	tx, err := lto.NewTransfer().
+		WithNetwork(lto.NetworkTest).
+		WithRecipient(testRecipient).
+		WithAmount(100000000).
+		Create()
+	require.NoError(t, err)
+
+	tx, err = tx.SignWith(a)
+	require.NoError(t, err)
+
+	_, err = api.TransactionsBroadcast(tx)
+	require.NoError(t, err)
+
+	_, err = node.Mine()
+	require.NoError(t, err)
+
+	return node, api, a, tx
+}
+
+func TestAPI_Balance(t *testing.T) {
+	node, api, a, _ := newTestNode(t)
+	defer node.Close()
+
+	got, err := api.AddressBalance(a.Address)
+	require.NoError(t, err)
+	require.Equal(t, a.Address, got.Address)
+	require.Equal(t, int64(9*100000000-lto.TransferFee), got.Balance)
+}
+
+func TestAPI_BalanceWithConfirmations(t *testing.T) {
+	node, api, _, _ := newTestNode(t)
+	defer node.Close()
+
+	got, err := api.AddressBalanceWithConfirmations(testRecipient, 10)
+	require.NoError(t, err)
+	require.Equal(t, testRecipient, got.Address)
+	require.Equal(t, int64(10), got.Confirmations)
+	require.Equal(t, int64(100000000), got.Balance)
+}
+
+func TestAPI_BalanceDetails(t *testing.T) {
+	node, api, a, _ := newTestNode(t)
+	defer node.Close()
+
+	got, err := api.AddressBalanceDetails(a.Address)
+	require.NoError(t, err)
+	require.Equal(t, a.Address, got.Address)
+	require.Equal(t, int64(9*100000000-lto.TransferFee), got.Regular)
+	require.Equal(t, got.Regular, got.Available)
+	require.Equal(t, got.Regular, got.Effective)
+}
+
+func TestAPI_BlocksLast(t *testing.T) {
+	node, api, _, tx := newTestNode(t)
+	defer node.Close()
+
+	res, err := api.BlocksLast()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.Height)
+	require.Len(t, res.Transactions, 1)
+	require.Equal(t, tx.ID, res.Transactions[0].GetBase().ID)
+}
+
+func TestAPI_BlocksFirst(t *testing.T) {
+	node, api, a, _ := newTestNode(t)
+	defer node.Close()
+
+	res, err := api.BlocksFirst()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Height)
+	require.Len(t, res.Transactions, 1)
+
+	genesis, ok := res.Transactions[0].(*lto.Genesis)
+	require.True(t, ok)
+	require.Equal(t, []byte(a.Address), genesis.Recipient)
+}
+
+func TestAPI_BlocksHeight(t *testing.T) {
+	node, api, _, _ := newTestNode(t)
+	defer node.Close()
+
+	res, err := api.BlocksHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.Height)
+}
+
+func TestAPI_BlocksAt(t *testing.T) {
+	node, api, _, _ := newTestNode(t)
+	defer node.Close()
+
+	res, err := api.BlocksAt(2)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.Height)
+
+	first, err := api.BlocksAt(1)
+	require.NoError(t, err)
+	require.Equal(t, first.Signature, res.Reference)
+
+	_, err = api.BlocksAt(100)
+	require.True(t, lto.IsNotFound(err))
+}
+
+func TestAPI_BlocksGet(t *testing.T) {
+	node, api, _, _ := newTestNode(t)
+	defer node.Close()
+
+	last, err := api.BlocksLast()
+	require.NoError(t, err)
+
+	res, err := api.BlocksGet(last.Signature)
+	require.NoError(t, err)
+	require.Equal(t, last.Height, res.Height)
+}
+
+func TestAPI_UtilsTime(t *testing.T) {
+	node, api, _, _ := newTestNode(t)
+	defer node.Close()
+
+	res, err := api.UtilsTime()
+	require.NoError(t, err)
+	require.NotZero(t, res.System)
+	require.NotZero(t, res.NTP)
+}
+
+func TestAPI_TransactionsGet(t *testing.T) {
+	node, api, _, tx := newTestNode(t)
+	defer node.Close()
+
+	res, err := api.TransactionsGet(tx.ID)
+	require.NoError(t, err)
+
+	transfer, ok := res.(*lto.Transfer)
+	require.True(t, ok)
+	require.Equal(t, tx.Amount, transfer.Amount)
+	require.Equal(t, int64(2), transfer.Height)
+}
+
+func TestAPI_TransactionsGetList(t *testing.T) {
+	node, api, a, tx := newTestNode(t)
+	defer node.Close()
+
+	res, err := api.TransactionsGetList(a.Address, 2)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, tx.ID, res[0].GetBase().ID)
+	require.IsType(t, &lto.Genesis{}, res[1])
+}
+
+func TestAPI_TransactionsUTXSize(t *testing.T) {
+	node, api, _, _ := newTestNode(t)
+	defer node.Close()
+
+	res, err := api.TransactionsUTXSize()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.Size)
+}
+
+func TestAPI_TransactionsUTXGet(t *testing.T) {
+	node, api, _, _ := newTestNode(t)
+	defer node.Close()
+
+	_, err := api.TransactionsUTXGet("asd")
+	require.Error(t, err)
+	require.True(t, lto.IsNotFound(err))
+}
+
+func TestAPI_TransactionsUTXGetList(t *testing.T) {
+	node, api, a, _ := newTestNode(t)
+	defer node.Close()
+
+	res, err := api.TransactionsUTXGetList()
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	tx, err := lto.NewAnchor().WithNetwork(lto.NetworkTest).WithAnchors(make([]byte, 32)).Create()
+	require.NoError(t, err)
+
+	tx, err = tx.SignWith(a)
+	require.NoError(t, err)
+
+	_, err = api.TransactionsBroadcast(tx)
+	require.NoError(t, err)
+
+	res, err = api.TransactionsUTXGetList()
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, tx.ID, res[0].GetBase().ID)
+}
@@ -1,16 +1,28 @@
 package lto
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
 
 	"github.com/davecgh/go-spew/spew"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
-func TestAPI_Balance(t *testing.T) {
+func TestAPI_UtilsCompile(t *testing.T) {
+	t.Skip()
+	// TODO Find an example for a valid script
 	type fields struct {
 		config *Config
 	}
@@ -40,579 +52,987 @@ func TestAPI_Balance(t *testing.T) {
 			api, err := NewAPI(tt.fields.config)
 			require.NoError(t, err)
 
-			lto, err := NewClient().WithNetwork(NetworkTest).Create()
-			require.NoError(t, err)
-
-			a, err := lto.NewAccount().Create()
+			res, err := api.UtilsCompile("")
 			require.NoError(t, err)
-
-			spew.Dump(a.Address)
-
-			got, err := api.AddressBalance(a.Address)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("AddressBalance() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-
-			spew.Dump(crypto.Base58Encode(got.Address))
-			spew.Dump(got)
+			require.NotNil(t, res)
+			spew.Dump(res)
 		})
 	}
 }
 
-func TestAPI_BalanceWithConfirmations(t *testing.T) {
-	type fields struct {
-		config *Config
-	}
-	type args struct {
-		confirmations int
-	}
+func TestAPI_TransactionsBroadcast(t *testing.T) {
 	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
+		name       string
+		status     int
+		response   string
+		wantErr    bool
+		wantErrMsg string
 	}{
 		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
+			name:   "should return the accepted transaction",
+			status: http.StatusOK,
+		},
+		{
+			name:       "should return a validation error",
+			status:     http.StatusBadRequest,
+			response:   `{"error":112,"message":"State check failed. Reason: negative lto balance","tx":{"type":4}}`,
+			wantErr:    true,
+			wantErrMsg: "State check failed. Reason: negative lto balance (error 112)",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/transactions/broadcast", r.URL.Path)
+				require.Equal(t, http.MethodPost, r.Method)
+
+				body, err := ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				if tt.response != "" {
+					_, _ = w.Write([]byte(tt.response))
+				} else {
+					_, _ = w.Write(body)
+				}
+			}))
+			defer server.Close()
+
+			config := DefaultTestNetConfig()
+			config.NodeAddress = server.URL
+
+			api, err := NewAPI(config)
 			require.NoError(t, err)
 
-			lto, err := NewClient().WithNetwork(NetworkTest).Create()
+			a, err := NewAccount().WithNetwork(NetworkTest).Create()
 			require.NoError(t, err)
 
-			a, err := lto.NewAccount().Create()
+			tx, err := NewTransfer().
+				WithRecipient(crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")).
+				WithAmount(100000000).
+				Create()
 			require.NoError(t, err)
 
-			spew.Dump(a.Address)
+			tx, err = tx.SignWith(a)
+			require.NoError(t, err)
 
-			got, err := api.AddressBalanceWithConfirmations(a.Address, 10)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("AddressBalance() error = %v, wantErr %v", err, tt.wantErr)
+			res, err := api.TransactionsBroadcast(tx)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.IsType(t, &APIError{}, err)
+				require.Equal(t, tt.wantErrMsg, err.Error())
+				require.Equal(t, "/transactions/broadcast", err.(*APIError).Path)
+				require.True(t, IsInsufficientBalance(err))
 				return
 			}
 
-			spew.Dump(crypto.Base58Encode(got.Address))
-			spew.Dump(got)
+			require.NoError(t, err)
+			require.Equal(t, tx.ID, res.GetBase().ID)
+
+			body, err := res.GetBodyBytes()
+			require.NoError(t, err)
+			wantBody, err := tx.GetBodyBytes()
+			require.NoError(t, err)
+			require.Equal(t, wantBody, body)
 		})
 	}
 }
 
-func TestAPI_BalanceDetails(t *testing.T) {
-	type fields struct {
-		config *Config
-	}
-	type args struct {
-		confirmations int
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
-	}{
-		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
-			require.NoError(t, err)
+func TestAPI_LeasingActive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/leasing/active/3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8", r.URL.Path)
 
-			lto, err := NewClient().WithNetwork(NetworkTest).Create()
-			require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{
+			"type": 8,
+			"version": 3,
+			"id": "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU",
+			"sender": "3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1",
+			"senderKeyType": "ed25519",
+			"senderPublicKey": "GjSacB6a5DFNEHjDSmn724QsrRStKYzkahPH67wyrhAY",
+			"fee": 100000000,
+			"timestamp": 1519862400000,
+			"recipient": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8",
+			"amount": 1000000000,
+			"proofs": [],
+			"height": 100
+		}]`))
+	}))
+	defer server.Close()
 
-			a, err := lto.NewAccount().Create()
-			require.NoError(t, err)
+	config := DefaultTestNetConfig()
+	config.NodeAddress = server.URL
 
-			spew.Dump(a.Address)
+	api, err := NewAPI(config)
+	require.NoError(t, err)
 
-			got, err := api.AddressBalanceDetails(a.Address)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("AddressBalance() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
+	res, err := api.LeasingActive(crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8"))
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU", res[0].ID)
+	require.Equal(t, NetworkTest, res[0].Network)
+	require.Equal(t, crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8"), res[0].Recipient)
+	require.Equal(t, int64(1000000000), res[0].Amount)
+	require.Equal(t, int64(100), res[0].Height)
+}
 
-			spew.Dump(crypto.Base58Encode(got.Address))
-			spew.Dump(got)
-		})
+func TestAPI_AssociationsStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/associations/status/3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"address": "3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1",
+			"outgoingAssociations": [{
+				"associationType": 42,
+				"party": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8",
+				"hash": "",
+				"timestamp": 1519862400000,
+				"transactionId": "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU",
+				"height": 100
+			}],
+			"incomingAssociations": [{
+				"associationType": 1,
+				"party": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8",
+				"hash": "2ar3wSjTm1fA33qgckZ5Kxn1x89gKcDPBXTxw56Yukd",
+				"timestamp": 1519862400000,
+				"transactionId": "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU",
+				"height": 100,
+				"revokeTransactionId": "72gRWx4C1Egqz9xvUBCYVdgh7uLc5kmGbjXFhiknNCTW",
+				"revokeTimestamp": 1519862500000,
+				"revokeHeight": 110
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	config := DefaultTestNetConfig()
+	config.NodeAddress = server.URL
+
+	api, err := NewAPI(config)
+	require.NoError(t, err)
+
+	res, err := api.AssociationsStatus(crypto.Base58Decode("3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1"))
+	require.NoError(t, err)
+	require.Equal(t, Address(crypto.Base58Decode("3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1")), res.Address)
+
+	require.Len(t, res.OutgoingAssociations, 1)
+	require.Equal(t, int32(42), res.OutgoingAssociations[0].AssociationType)
+	require.Equal(t, "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8", res.OutgoingAssociations[0].Party.String())
+	require.False(t, res.OutgoingAssociations[0].IsRevoked())
+
+	require.Len(t, res.IncomingAssociations, 1)
+	require.Equal(t, crypto.Base58Decode("2ar3wSjTm1fA33qgckZ5Kxn1x89gKcDPBXTxw56Yukd"), res.IncomingAssociations[0].Hash)
+	require.True(t, res.IncomingAssociations[0].IsRevoked())
+	require.Equal(t, int64(110), res.IncomingAssociations[0].RevokeHeight)
+}
+
+func TestAPI_AddressData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/addresses/data/3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1":
+			_, _ = w.Write([]byte(`[
+				{"key": "a", "type": "integer", "value": 9007199254740993},
+				{"key": "b", "type": "boolean", "value": true},
+				{"key": "c", "type": "binary", "value": "base64:AQI="},
+				{"key": "d", "type": "string", "value": "hi"}
+			]`))
+		case "/addresses/data/3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1/my key":
+			_, _ = w.Write([]byte(`{"key": "my key", "type": "string", "value": "hi"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := DefaultTestNetConfig()
+	config.NodeAddress = server.URL
+
+	api, err := NewAPI(config)
+	require.NoError(t, err)
+
+	address := crypto.Base58Decode("3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1")
+
+	entries, err := api.AddressData(address)
+	require.NoError(t, err)
+	require.Equal(t, []*DataEntry{
+		{Key: "a", Type: DataEntryTypeInteger, Value: int64(9007199254740993)},
+		{Key: "b", Type: DataEntryTypeBoolean, Value: true},
+		{Key: "c", Type: DataEntryTypeBinary, Value: []byte{1, 2}},
+		{Key: "d", Type: DataEntryTypeString, Value: "hi"},
+	}, entries)
+
+	entry, err := api.AddressDataByKey(address, "my key")
+	require.NoError(t, err)
+	require.Equal(t, &DataEntry{Key: "my key", Type: DataEntryTypeString, Value: "hi"}, entry)
+}
+
+func TestAPI_AddressScriptInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/addresses/scriptInfo/3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1":
+			_, _ = w.Write([]byte(`{
+				"address": "3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1",
+				"script": "base64:AQa3b8tH",
+				"scriptText": "TRUE",
+				"complexity": 1,
+				"extraFee": 400000
+			}`))
+		case "/addresses/scriptInfo/3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8":
+			_, _ = w.Write([]byte(`{
+				"address": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8",
+				"complexity": 0,
+				"extraFee": 0
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := DefaultTestNetConfig()
+	config.NodeAddress = server.URL
+
+	api, err := NewAPI(config)
+	require.NoError(t, err)
+
+	res, err := api.AddressScriptInfo(crypto.Base58Decode("3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01, 0x06, 0xb7, 0x6f, 0xcb, 0x47}, res.Script)
+	require.Equal(t, "TRUE", res.ScriptText)
+	require.Equal(t, int64(1), res.Complexity)
+	require.Equal(t, int64(400000), res.ExtraFee)
+
+	res, err = api.AddressScriptInfo(crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8"))
+	require.NoError(t, err)
+	require.Nil(t, res.Script)
+	require.Equal(t, int64(0), res.ExtraFee)
+}
+
+func TestAPI_UtilsEstimate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/utils/script/estimate", r.URL.Path)
+		require.Equal(t, http.MethodPost, r.Method)
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "base64:AQa3b8tH", string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"script": "base64:AQa3b8tH",
+			"scriptText": "TRUE",
+			"complexity": 1,
+			"extraFee": 400000
+		}`))
+	}))
+	defer server.Close()
+
+	config := DefaultTestNetConfig()
+	config.NodeAddress = server.URL
+
+	api, err := NewAPI(config)
+	require.NoError(t, err)
+
+	res, err := api.UtilsEstimate("base64:AQa3b8tH")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Complexity)
+	require.Equal(t, int64(400000), res.ExtraFee)
+}
+
+func TestAPI_BlocksAt_Transactions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/blocks/at/100", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"version": 3,
+			"timestamp": 1519862400000,
+			"reference": "4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd",
+			"generator": "3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1",
+			"signature": "3QxN6BXuWJdvpuYsyxxLHjPq9xW2cJ1sQ2GmDCXHBkiSc2XQd37ngmu1LtrHJmkkvbELk1Cz4pTHvpr79h9hqP9B",
+			"transactionCount": 2,
+			"fee": 135000000,
+			"transactions": [{
+				"type": 4,
+				"version": 3,
+				"id": "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU",
+				"sender": "3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1",
+				"senderKeyType": "ed25519",
+				"senderPublicKey": "GjSacB6a5DFNEHjDSmn724QsrRStKYzkahPH67wyrhAY",
+				"fee": 100000000,
+				"timestamp": 1519862400000,
+				"recipient": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8",
+				"amount": 1000000000,
+				"attachment": "",
+				"proofs": []
+			}, {
+				"type": 9,
+				"version": 3,
+				"id": "72gRWx4C1Egqz9xvUBCYVdgh7uLc5kmGbjXFhiknNCTW",
+				"sender": "3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1",
+				"senderKeyType": "ed25519",
+				"senderPublicKey": "GjSacB6a5DFNEHjDSmn724QsrRStKYzkahPH67wyrhAY",
+				"fee": 35000000,
+				"timestamp": 1519862400000,
+				"leaseId": "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU",
+				"proofs": []
+			}],
+			"height": 100
+		}`))
+	}))
+	defer server.Close()
+
+	config := DefaultTestNetConfig()
+	config.NodeAddress = server.URL
+
+	api, err := NewAPI(config)
+	require.NoError(t, err)
+
+	res, err := api.BlocksAt(100)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), res.Height)
+	require.Equal(t, int64(2), res.TransactionCount)
+	require.Len(t, res.Transactions, 2)
+
+	transfer, ok := res.Transactions[0].(*Transfer)
+	require.True(t, ok)
+	require.Equal(t, int64(1000000000), transfer.Amount)
+
+	cancelLease, ok := res.Transactions[1].(*CancelLease)
+	require.True(t, ok)
+	require.Equal(t, "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU", cancelLease.LeaseID)
+}
+
+func TestAPI_TransactionsGetList_Flatten(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/transactions/address/3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1/limit/2", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[[{
+			"type": 15,
+			"version": 3,
+			"id": "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU",
+			"sender": "3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1",
+			"senderKeyType": "ed25519",
+			"senderPublicKey": "GjSacB6a5DFNEHjDSmn724QsrRStKYzkahPH67wyrhAY",
+			"fee": 35000000,
+			"timestamp": 1519862400000,
+			"anchors": ["2ar3wSjTm1fA33qgckZ5Kxn1x89gKcDPBXTxw56Yukd"],
+			"proofs": ["4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd"],
+			"height": 100
+		}, {
+			"type": 8,
+			"version": 3,
+			"id": "72gRWx4C1Egqz9xvUBCYVdgh7uLc5kmGbjXFhiknNCTW",
+			"sender": "3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1",
+			"senderKeyType": "ed25519",
+			"senderPublicKey": "GjSacB6a5DFNEHjDSmn724QsrRStKYzkahPH67wyrhAY",
+			"fee": 100000000,
+			"timestamp": 1519862400000,
+			"recipient": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8",
+			"amount": 1000000000,
+			"proofs": [],
+			"height": 99
+		}]]`))
+	}))
+	defer server.Close()
+
+	config := DefaultTestNetConfig()
+	config.NodeAddress = server.URL
+
+	api, err := NewAPI(config)
+	require.NoError(t, err)
+
+	res, err := api.TransactionsGetList(crypto.Base58Decode("3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1"), 2)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	anchor, ok := res[0].(*Anchor)
+	require.True(t, ok)
+	require.Len(t, anchor.Anchors, 1)
+	require.Len(t, anchor.Proofs, 1)
+	require.Equal(t, int64(100), anchor.Height)
+
+	lease, ok := res[1].(*Lease)
+	require.True(t, ok)
+	require.Equal(t, "72gRWx4C1Egqz9xvUBCYVdgh7uLc5kmGbjXFhiknNCTW", lease.ID)
+}
+
+func TestAPI_Context(t *testing.T) {
+	const delay = 2 * time.Second
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(delay):
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"height":42}`))
+		}
+	}))
+	defer server.Close()
+
+	t.Run("should cancel the request when the context is done", func(t *testing.T) {
+		config := DefaultTestNetConfig()
+		config.NodeAddress = server.URL
+		config.Timeout = 0
+
+		api, err := NewAPI(config)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err = api.BlocksHeightContext(ctx)
+		require.Error(t, err)
+		require.True(t, time.Since(start) < delay/2)
+
+		urlErr, ok := errors.Cause(err).(*url.Error)
+		require.True(t, ok, "%v", err)
+		require.Equal(t, context.DeadlineExceeded, urlErr.Err)
+	})
+
+	t.Run("should stop the request after the timeout of the config", func(t *testing.T) {
+		config := DefaultTestNetConfig()
+		config.NodeAddress = server.URL
+		config.Timeout = 20 * time.Millisecond
+
+		api, err := NewAPI(config)
+		require.NoError(t, err)
+
+		start := time.Now()
+		_, err = api.BlocksHeight()
+		require.Error(t, err)
+		require.True(t, time.Since(start) < delay/2)
+
+		urlErr, ok := errors.Cause(err).(*url.Error)
+		require.True(t, ok, "%v", err)
+		require.True(t, urlErr.Timeout())
+	})
+}
+
+/**
+ * Node responding with its height, its requests and health checks can be made to fail
+ */
+type fakeNode struct {
+	height int64
+
+	failRequests int32
+	failChecks   int32
+
+	requests int32
+	checks   int32
+}
+
+func (n *fakeNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	failed := &n.failRequests
+	count := &n.requests
+	if r.URL.Path == "/node/status" {
+		failed = &n.failChecks
+		count = &n.checks
 	}
+
+	atomic.AddInt32(count, 1)
+
+	if atomic.LoadInt32(failed) != 0 {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(fmt.Sprintf(`{"height":%d}`, n.height)))
 }
 
-func TestAPI_BlocksLast(t *testing.T) {
-	type fields struct {
-		config *Config
+func (n *fakeNode) fail(requests bool, checks bool) {
+	toInt := func(b bool) int32 {
+		if b {
+			return 1
+		}
+		return 0
 	}
-	type args struct {
-		confirmations int
+
+	atomic.StoreInt32(&n.failRequests, toInt(requests))
+	atomic.StoreInt32(&n.failChecks, toInt(checks))
+}
+
+func (n *fakeNode) counts() (int32, int32) {
+	return atomic.LoadInt32(&n.requests), atomic.LoadInt32(&n.checks)
+}
+
+func TestAPI_Failover(t *testing.T) {
+	first := &fakeNode{height: 42}
+	firstServer := httptest.NewServer(first)
+	defer firstServer.Close()
+
+	second := &fakeNode{height: 43}
+	secondServer := httptest.NewServer(second)
+	defer secondServer.Close()
+
+	newConfig := func() *Config {
+		config := DefaultTestNetConfig()
+		config.NodeAddress = firstServer.URL
+		config.NodeAddresses = []string{secondServer.URL}
+		config.RetryWaitTime = time.Millisecond
+		config.RetryMaxWaitTime = time.Millisecond
+		return config
 	}
-	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
-	}{
-		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
-		},
+
+	reset := func() {
+		*first = fakeNode{height: 42}
+		*second = fakeNode{height: 43}
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
-			require.NoError(t, err)
 
-			res, err := api.BlocksLast()
+	getHeights := func(t *testing.T, api *API, n int) []int64 {
+		var heights []int64
+		for i := 0; i < n; i++ {
+			height, err := api.BlocksHeight()
 			require.NoError(t, err)
-			require.NotNil(t, res)
-			spew.Dump(res)
-		})
+			heights = append(heights, height.Height)
+		}
+
+		return heights
 	}
+
+	t.Run("should skip a node that fails the health check", func(t *testing.T) {
+		reset()
+		first.fail(true, true)
+
+		api, err := NewAPI(newConfig())
+		require.NoError(t, err)
+
+		require.Equal(t, []int64{43, 43, 43}, getHeights(t, api, 3))
+
+		requests, checks := first.counts()
+		require.Equal(t, int32(0), requests)
+		require.Equal(t, int32(1), checks)
+
+		requests, checks = second.counts()
+		require.Equal(t, int32(3), requests)
+		require.Equal(t, int32(1), checks)
+	})
+
+	t.Run("should retry a GET request on the next node and skip the failed node during the cooldown", func(t *testing.T) {
+		reset()
+		first.fail(true, false)
+
+		api, err := NewAPI(newConfig())
+		require.NoError(t, err)
+
+		require.Equal(t, []int64{43, 43, 43}, getHeights(t, api, 3))
+
+		requests, checks := first.counts()
+		require.Equal(t, int32(1), requests)
+		require.Equal(t, int32(1), checks)
+
+		requests, _ = second.counts()
+		require.Equal(t, int32(3), requests)
+	})
+
+	t.Run("should check the health of the failed node before it re-enters rotation", func(t *testing.T) {
+		reset()
+		first.fail(true, true)
+
+		config := newConfig()
+		config.NodeCooldown = 20 * time.Millisecond
+
+		api, err := NewAPI(config)
+		require.NoError(t, err)
+
+		require.Equal(t, []int64{43}, getHeights(t, api, 1))
+
+		time.Sleep(30 * time.Millisecond)
+		require.Equal(t, []int64{43}, getHeights(t, api, 1))
+
+		requests, checks := first.counts()
+		require.Equal(t, int32(0), requests)
+		require.Equal(t, int32(2), checks)
+
+		first.fail(false, false)
+
+		time.Sleep(30 * time.Millisecond)
+		require.Equal(t, []int64{42, 42}, getHeights(t, api, 2))
+
+		requests, checks = first.counts()
+		require.Equal(t, int32(2), requests)
+		require.Equal(t, int32(3), checks)
+	})
+
+	t.Run("should not retry a request other than GET", func(t *testing.T) {
+		reset()
+		first.fail(true, true)
+
+		config := newConfig()
+		config.NodeAddresses = nil
+
+		api, err := NewAPI(config)
+		require.NoError(t, err)
+
+		_, err = api.UtilsEstimate("AQa3b8tH")
+		require.Error(t, err)
+
+		requests, checks := first.counts()
+		require.Equal(t, int32(1), requests)
+		require.Equal(t, int32(0), checks, "a single node is not health checked")
+	})
+
+	t.Run("should give up after the retry count", func(t *testing.T) {
+		reset()
+		first.fail(true, true)
+
+		config := newConfig()
+		config.NodeAddresses = nil
+		config.RetryCount = 2
+
+		api, err := NewAPI(config)
+		require.NoError(t, err)
+
+		_, err = api.BlocksHeight()
+		require.Error(t, err)
+
+		requests, _ := first.counts()
+		require.Equal(t, int32(3), requests)
+	})
+
+	t.Run("should spread requests over the nodes with round-robin", func(t *testing.T) {
+		reset()
+
+		config := newConfig()
+		config.NodeSelection = NodeSelectionRoundRobin
+
+		api, err := NewAPI(config)
+		require.NoError(t, err)
+
+		require.Equal(t, []int64{42, 43, 42, 43}, getHeights(t, api, 4))
+
+		_, checks := first.counts()
+		require.Equal(t, int32(1), checks)
+	})
+
+	t.Run("should fail without a node address", func(t *testing.T) {
+		config := DefaultTestNetConfig()
+		config.NodeAddress = ""
+
+		_, err := NewAPI(config)
+		require.Error(t, err)
+	})
 }
 
-func TestAPI_BlocksFirst(t *testing.T) {
-	type fields struct {
-		config *Config
-	}
-	type args struct {
-		confirmations int
-	}
+func TestAPI_Error(t *testing.T) {
 	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
+		name         string
+		status       int
+		response     string
+		wantCode     int
+		wantMessage  string
+		wantErrMsg   string
+		wantNotFound bool
+		wantInvalid  bool
+		wantInUTX    bool
 	}{
 		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
+			name:         "should parse the error of the node",
+			status:       http.StatusNotFound,
+			response:     `{"error":311,"message":"transactions does not exist"}`,
+			wantCode:     APIErrorTransactionDoesNotExist,
+			wantMessage:  "transactions does not exist",
+			wantErrMsg:   "transactions does not exist (error 311)",
+			wantNotFound: true,
+		},
+		{
+			name:         "should use the body as message if it isn't a node error",
+			status:       http.StatusNotFound,
+			response:     "Not found\n",
+			wantMessage:  "Not found",
+			wantErrMsg:   "Not found (status 404)",
+			wantNotFound: true,
+		},
+		{
+			name:        "should use the status text without a body",
+			status:      http.StatusServiceUnavailable,
+			wantMessage: "Service Unavailable",
+			wantErrMsg:  "Service Unavailable (status 503)",
+		},
+		{
+			name:        "should detect an invalid signature",
+			status:      http.StatusBadRequest,
+			response:    `{"error":101,"message":"invalid signature"}`,
+			wantCode:    APIErrorInvalidSignature,
+			wantMessage: "invalid signature",
+			wantErrMsg:  "invalid signature (error 101)",
+			wantInvalid: true,
+		},
+		{
+			name:        "should detect a transaction that is already in the pool",
+			status:      http.StatusBadRequest,
+			response:    `{"error":199,"message":"Transaction 8QzU is already in the pool"}`,
+			wantCode:    APIErrorCustomValidation,
+			wantMessage: "Transaction 8QzU is already in the pool",
+			wantErrMsg:  "Transaction 8QzU is already in the pool (error 199)",
+			wantInUTX:   true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
-			require.NoError(t, err)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
 
-			res, err := api.BlocksFirst()
+			config := DefaultTestNetConfig()
+			config.NodeAddress = server.URL
+			config.RetryCount = 0
+
+			api, err := NewAPI(config)
 			require.NoError(t, err)
-			require.NotNil(t, res)
-			spew.Dump(res)
+
+			_, err = api.TransactionsGet("8QzU")
+			require.Error(t, err)
+			require.IsType(t, &APIError{}, err)
+
+			apiErr := err.(*APIError)
+			require.Equal(t, tt.status, apiErr.StatusCode)
+			require.Equal(t, tt.wantCode, apiErr.Code)
+			require.Equal(t, tt.wantMessage, apiErr.Message)
+			require.Equal(t, "/transactions/info/8QzU", apiErr.Path)
+			require.Equal(t, tt.wantErrMsg, err.Error())
+
+			require.Equal(t, tt.wantNotFound, IsNotFound(err))
+			require.Equal(t, tt.wantInvalid, IsInvalidSignature(err))
+			require.Equal(t, tt.wantInUTX, IsAlreadyInUTX(err))
+			require.False(t, IsInsufficientBalance(err))
+
+			require.Equal(t, tt.wantNotFound, IsNotFound(errors.Wrap(err, "wrapped")))
 		})
 	}
 }
 
-func TestAPI_BlocksHeight(t *testing.T) {
-	type fields struct {
-		config *Config
-	}
-	type args struct {
-		confirmations int
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
-	}{
-		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
-			require.NoError(t, err)
+func TestAPI_TransactionsIterator(t *testing.T) {
+	address, err := ParseAddress("3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1")
+	require.NoError(t, err)
 
-			res, err := api.BlocksHeight()
-			require.NoError(t, err)
-			require.NotNil(t, res)
-			spew.Dump(res)
-		})
-	}
-}
+	ids := []string{"tx5", "tx4", "tx3", "tx2", "tx1"}
 
-func TestAPI_BlocksAt(t *testing.T) {
-	type fields struct {
-		config *Config
-	}
-	type args struct {
-		confirmations int
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
-	}{
-		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
-			require.NoError(t, err)
+	var afters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/transactions/address/"+address.String()+"/limit/2", r.URL.Path)
 
-			res, err := api.BlocksAt(100)
-			require.NoError(t, err)
-			require.NotNil(t, res)
-			spew.Dump(res)
-		})
-	}
-}
+		after := r.URL.Query().Get("after")
+		afters = append(afters, after)
 
-func TestAPI_BlocksGet(t *testing.T) {
-	type fields struct {
-		config *Config
-	}
-	type args struct {
-		confirmations int
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
-	}{
-		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
-			require.NoError(t, err)
+		start := 0
+		for i, id := range ids {
+			if id == after {
+				start = i + 1
+			}
+		}
 
-			res, err := api.BlocksGet("hXTXbA4wWtM7HSZqd4YZbYAFfVqTcAtcXzjEVAwxcJoGuC46C8t3ewiKdUybHsdBk6YsPUUzY3Zkr7Ww9qejzPh")
-			require.NoError(t, err)
-			require.NotNil(t, res)
-			spew.Dump(res)
-		})
-	}
-}
+		end := start + 2
+		if end > len(ids) {
+			end = len(ids)
+		}
 
-func TestAPI_UtilsTime(t *testing.T) {
-	type fields struct {
-		config *Config
-	}
-	type args struct {
-		confirmations int
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
-	}{
-		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
-			require.NoError(t, err)
+		var items []string
+		for _, id := range ids[start:end] {
+			items = append(items, fmt.Sprintf(`{
+				"type": 8,
+				"version": 3,
+				"id": "%s",
+				"sender": "%s",
+				"senderKeyType": "ed25519",
+				"senderPublicKey": "GjSacB6a5DFNEHjDSmn724QsrRStKYzkahPH67wyrhAY",
+				"fee": 100000000,
+				"timestamp": 1519862400000,
+				"recipient": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8",
+				"amount": 1000000000,
+				"proofs": []
+			}`, id, address))
+		}
 
-			res, err := api.UtilsTime()
-			require.NoError(t, err)
-			require.NotNil(t, res)
-			spew.Dump(res)
-		})
-	}
-}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[[" + strings.Join(items, ",") + "]]"))
+	}))
+	defer server.Close()
 
-func TestAPI_UtilsCompile(t *testing.T) {
-	t.Skip()
-	// TODO Find an example for a valid script
-	type fields struct {
-		config *Config
-	}
-	type args struct {
-		confirmations int
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
-	}{
-		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
-			require.NoError(t, err)
+	newAPI := func(offset int) *API {
+		config := DefaultTestNetConfig()
+		config.NodeAddress = server.URL
+		config.RequestLimit = 2
+		config.RequestOffset = offset
 
-			res, err := api.UtilsCompile("")
-			require.NoError(t, err)
-			require.NotNil(t, res)
-			spew.Dump(res)
-		})
-	}
-}
+		api, err := NewAPI(config)
+		require.NoError(t, err)
 
-func TestAPI_TransactionsGet(t *testing.T) {
-	type fields struct {
-		config *Config
+		return api
 	}
-	type args struct {
-		confirmations int
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
-	}{
-		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
-			require.NoError(t, err)
 
-			res, err := api.TransactionsGet("5C1sBMVCkaS1hr97C5zQCPtvzdF6ubqNauycHesHJ1nyDx7hiaDTdPxwzqJuKNebjho3egWzMVCFxMefNgncSbpp")
-			require.NoError(t, err)
-			require.NotNil(t, res)
-			spew.Dump(res)
-		})
-	}
-}
+	t.Run("should walk through all pages using the after cursor", func(t *testing.T) {
+		afters = nil
 
-func TestAPI_TransactionsGetList(t *testing.T) {
-	type fields struct {
-		config *Config
-	}
-	type args struct {
-		confirmations int
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
-	}{
-		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
-			require.NoError(t, err)
+		var got []string
+		it := newAPI(0).TransactionsIterator(address)
+		for it.Next() {
+			_, ok := it.Transaction().(*Lease)
+			require.True(t, ok)
+			got = append(got, it.Transaction().GetBase().ID)
+		}
 
-			res, err := api.TransactionsGetList("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8", 2)
-			require.NoError(t, err)
-			require.NotNil(t, res)
-			spew.Dump(res)
-		})
-	}
-}
+		require.NoError(t, it.Err())
+		require.Equal(t, ids, got)
+		require.Equal(t, []string{"", "tx4", "tx2"}, afters)
+	})
 
-func TestAPI_TransactionsUTXSize(t *testing.T) {
-	type fields struct {
-		config *Config
-	}
-	type args struct {
-		confirmations int
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
-	}{
-		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
-			require.NoError(t, err)
+	t.Run("should skip the request offset", func(t *testing.T) {
+		var got []string
+		it := newAPI(3).TransactionsIterator(address)
+		for it.Next() {
+			got = append(got, it.Transaction().GetBase().ID)
+		}
 
-			res, err := api.TransactionsUTXSize()
-			require.NoError(t, err)
-			require.NotNil(t, res)
-			spew.Dump(res)
-		})
-	}
-}
+		require.NoError(t, it.Err())
+		require.Equal(t, []string{"tx2", "tx1"}, got)
+	})
 
-func TestAPI_TransactionsUTXGet(t *testing.T) {
-	type fields struct {
-		config *Config
-	}
-	type args struct {
-		confirmations int
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
-	}{
-		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
-			require.NoError(t, err)
+	t.Run("should stop when the context is done", func(t *testing.T) {
+		afters = nil
 
-			res, err := api.TransactionsUTXGet("asd")
-			require.Error(t, err)
-			spew.Dump(res)
-		})
-	}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var got []string
+		it := newAPI(0).TransactionsIteratorContext(ctx, address)
+		for it.Next() {
+			got = append(got, it.Transaction().GetBase().ID)
+			cancel()
+		}
+
+		require.Error(t, it.Err())
+		require.Equal(t, context.Canceled, errors.Cause(it.Err()))
+		require.Equal(t, []string{"tx5"}, got)
+		require.Len(t, afters, 1)
+	})
 }
 
-func TestAPI_TransactionsUTXGetList(t *testing.T) {
-	type fields struct {
-		config *Config
-	}
-	type args struct {
-		confirmations int
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		want    *BalanceResponse
-		wantErr bool
-	}{
-		{
-			name: "",
-			fields: fields{
-				config: DefaultTestNetConfig(),
-			},
-			want: &BalanceResponse{
-				Confirmations: 0,
-				Balance:       0,
-			},
-			wantErr: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			api, err := NewAPI(tt.fields.config)
-			require.NoError(t, err)
+func TestAPI_BlocksRange(t *testing.T) {
+	const header = `{
+		"version": 3,
+		"timestamp": 1519862400000,
+		"reference": "4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd",
+		"generator": "3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1",
+		"signature": "3QxN6BXuWJdvpuYsyxxLHjPq9xW2cJ1sQ2GmDCXHBkiSc2XQd37ngmu1LtrHJmkkvbELk1Cz4pTHvpr79h9hqP9B",
+		"transactionCount": 1,
+		"fee": 35000000,
+		"height": %d
+	}`
+	const block = `{
+		"version": 3,
+		"timestamp": 1519862400000,
+		"generator": "3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1",
+		"signature": "3QxN6BXuWJdvpuYsyxxLHjPq9xW2cJ1sQ2GmDCXHBkiSc2XQd37ngmu1LtrHJmkkvbELk1Cz4pTHvpr79h9hqP9B",
+		"transactionCount": 1,
+		"transactions": [{
+			"type": 9,
+			"version": 3,
+			"id": "72gRWx4C1Egqz9xvUBCYVdgh7uLc5kmGbjXFhiknNCTW",
+			"sender": "3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1",
+			"senderKeyType": "ed25519",
+			"senderPublicKey": "GjSacB6a5DFNEHjDSmn724QsrRStKYzkahPH67wyrhAY",
+			"fee": 35000000,
+			"timestamp": 1519862400000,
+			"leaseId": "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU",
+			"proofs": []
+		}],
+		"height": %d
+	}`
 
-			res, err := api.TransactionsUTXGetList()
-			require.NoError(t, err)
-			require.NotNil(t, res)
-			spew.Dump(res)
-		})
+	responses := map[string]string{
+		"/blocks/seq/100/101":                                       "[" + fmt.Sprintf(block, 100) + "," + fmt.Sprintf(block, 101) + "]",
+		"/blocks/headers/seq/100/102":                               "[" + fmt.Sprintf(header, 100) + "," + fmt.Sprintf(header, 101) + "," + fmt.Sprintf(header, 102) + "]",
+		"/blocks/headers/last":                                      fmt.Sprintf(header, 200),
+		"/blocks/address/3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1/1/200": "[" + fmt.Sprintf(block, 150) + "]",
+		"/blocks/child/4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd":    fmt.Sprintf(block, 101),
+		"/blocks/delay/3QxN6BXuWJdvpuYsyxxLHjPq9xW2cJ1sQ2GmDCXHBkiSc2XQd37ngmu1LtrHJmkkvbELk1Cz4pTHvpr79h9hqP9B/10": `{"delay":60000}`,
+		"/blocks/height/3QxN6BXuWJdvpuYsyxxLHjPq9xW2cJ1sQ2GmDCXHBkiSc2XQd37ngmu1LtrHJmkkvbELk1Cz4pTHvpr79h9hqP9B":   `{"height":101}`,
 	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(res))
+	}))
+	defer server.Close()
+
+	config := DefaultTestNetConfig()
+	config.NodeAddress = server.URL
+
+	api, err := NewAPI(config)
+	require.NoError(t, err)
+
+	const reference = "4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd"
+	const signature = "3QxN6BXuWJdvpuYsyxxLHjPq9xW2cJ1sQ2GmDCXHBkiSc2XQd37ngmu1LtrHJmkkvbELk1Cz4pTHvpr79h9hqP9B"
+
+	t.Run("BlocksSeq", func(t *testing.T) {
+		blocks, err := api.BlocksSeq(100, 101)
+		require.NoError(t, err)
+		require.Len(t, blocks, 2)
+		require.Equal(t, int64(101), blocks[1].Height)
+		require.IsType(t, &CancelLease{}, blocks[1].Transactions[0])
+	})
+
+	t.Run("BlocksHeadersSeq", func(t *testing.T) {
+		headers, err := api.BlocksHeadersSeq(100, 102)
+		require.NoError(t, err)
+		require.Len(t, headers, 3)
+		require.Equal(t, int64(102), headers[2].Height)
+		require.Equal(t, int64(1), headers[2].TransactionCount)
+		require.Equal(t, reference, headers[2].Reference)
+	})
+
+	t.Run("BlocksHeadersLast", func(t *testing.T) {
+		header, err := api.BlocksHeadersLast()
+		require.NoError(t, err)
+		require.Equal(t, int64(200), header.Height)
+		require.Equal(t, signature, header.Signature)
+	})
+
+	t.Run("BlocksAddress", func(t *testing.T) {
+		blocks, err := api.BlocksAddress(crypto.Base58Decode("3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1"), 1, 200)
+		require.NoError(t, err)
+		require.Len(t, blocks, 1)
+		require.Equal(t, int64(150), blocks[0].Height)
+	})
+
+	t.Run("BlocksChild", func(t *testing.T) {
+		block, err := api.BlocksChild(reference)
+		require.NoError(t, err)
+		require.Equal(t, int64(101), block.Height)
+	})
+
+	t.Run("BlocksDelay", func(t *testing.T) {
+		delay, err := api.BlocksDelay(signature, 10)
+		require.NoError(t, err)
+		require.Equal(t, int64(60000), delay.Delay)
+	})
+
+	t.Run("BlocksHeightBySignature", func(t *testing.T) {
+		height, err := api.BlocksHeightBySignature(signature)
+		require.NoError(t, err)
+		require.Equal(t, int64(101), height.Height)
+	})
+
+	t.Run("should return a not found error for an unknown block", func(t *testing.T) {
+		_, err := api.BlocksChild(signature)
+		require.True(t, IsNotFound(err))
+	})
 }
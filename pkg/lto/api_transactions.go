@@ -1,72 +1,183 @@
 package lto
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/pkg/errors"
 )
 
-type TransactionsGetResponse struct {
-	Type      int64  `json:"type"`
-	ID        string `json:"id"`
-	Fee       int64  `json:"fee"`
-	Timestamp int64  `json:"timestamp"`
-	Signature string `json:"signature"`
-	Recipient string `json:"recipient"`
-	Amount    int64  `json:"amount"`
-	Height    int64  `json:"height"`
+func (api *API) TransactionsGet(id string) (Transaction, error) {
+	return api.TransactionsGetContext(context.Background(), id)
 }
 
-func (api *API) TransactionsGet(id string) (*TransactionsGetResponse, error) {
-	res := new(TransactionsGetResponse)
+func (api *API) TransactionsGetContext(ctx context.Context, id string) (Transaction, error) {
+	var res json.RawMessage
 
 	path := fmt.Sprintf("/transactions/info/%s", id)
-	r, err := api.client.R().SetResult(res).Get(path)
+	r, err := api.client.R().SetContext(ctx).SetResult(&res).Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get transaction")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
 	}
 
-	return res, nil
+	return DecodeTransaction(res)
+}
+
+/**
+ * Most recent transactions of the address, newest first
+ */
+func (api *API) TransactionsGetList(address Address, limit int) ([]Transaction, error) {
+	return api.TransactionsGetListContext(context.Background(), address, limit)
+}
+
+func (api *API) TransactionsGetListContext(ctx context.Context, address Address, limit int) ([]Transaction, error) {
+	return api.TransactionsGetListAfterContext(ctx, address, limit, "")
 }
 
-type TransactionsGetListResponseItem struct {
-	Type            int64  `json:"type"`
-	ID              string `json:"id"`
-	Sender          string `json:"sender"`
-	SenderPublicKey string `json:"senderPublicKey"`
-	Fee             int64  `json:"fee"`
-	Timestamp       int64  `json:"timestamp"`
-	Signature       string `json:"signature"`
-	Version         int64  `json:"version"`
-	Recipient       string `json:"recipient"`
-	Amount          int64  `json:"amount"`
-	Status          string `json:"status"`
-	Attachment      string `json:"attachment"`
-	Height          int64  `json:"height"`
+/**
+ * Transactions of the address older than the transaction with the after id, newest first.
+ * Without after id this is the same as TransactionsGetList.
+ */
+func (api *API) TransactionsGetListAfter(address Address, limit int, after string) ([]Transaction, error) {
+	return api.TransactionsGetListAfterContext(context.Background(), address, limit, after)
 }
 
-func (api *API) TransactionsGetList(address string, limit int) ([][]*TransactionsGetListResponseItem, error) {
+func (api *API) TransactionsGetListAfterContext(ctx context.Context, address Address, limit int, after string) ([]Transaction, error) {
 	if limit == 0 {
 		limit = api.config.RequestLimit
 	}
 
-	var res [][]*TransactionsGetListResponseItem
+	var res [][]json.RawMessage
+
+	req := api.client.R().SetContext(ctx).SetResult(&res)
+	if after != "" {
+		req.SetQueryParam("after", after)
+	}
 
 	path := fmt.Sprintf("/transactions/address/%s/limit/%d", address, limit)
-	r, err := api.client.R().SetResult(&res).Get(path)
+	r, err := req.Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get transaction")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
 	}
 
-	return res, nil
+	var list []json.RawMessage
+	for _, items := range res {
+		list = append(list, items...)
+	}
+
+	return decodeTransactionList(list)
+}
+
+/**
+ * Walks through all transactions of an address, newest first, fetching a page at a time.
+ * The first RequestOffset transactions of the config are skipped and each page holds RequestLimit transactions.
+ *
+ *	it := api.TransactionsIterator(address)
+ *	for it.Next() {
+ *		tx := it.Transaction()
+ *	}
+ *	err := it.Err()
+ */
+type TransactionIterator struct {
+	api     *API
+	ctx     context.Context
+	address Address
+	limit   int
+	skip    int
+
+	page  []Transaction
+	index int
+	after string
+	last  bool
+
+	tx  Transaction
+	err error
+}
+
+func (api *API) TransactionsIterator(address Address) *TransactionIterator {
+	return api.TransactionsIteratorContext(context.Background(), address)
+}
+
+/**
+ * Iterate over the transactions of the address until the context is done
+ */
+func (api *API) TransactionsIteratorContext(ctx context.Context, address Address) *TransactionIterator {
+	return &TransactionIterator{
+		api:     api,
+		ctx:     ctx,
+		address: address,
+		limit:   api.config.RequestLimit,
+		skip:    api.config.RequestOffset,
+	}
+}
+
+/**
+ * Advance to the next transaction, false when there are no more transactions or an error occurred
+ */
+func (it *TransactionIterator) Next() bool {
+	if err := it.ctx.Err(); err != nil && it.err == nil {
+		it.err = errors.Wrapf(err, "stopped iterating transactions of %s", it.address)
+	}
+
+	for it.err == nil {
+		if it.index < len(it.page) {
+			it.tx = it.page[it.index]
+			it.index++
+
+			if it.skip > 0 {
+				it.skip--
+				continue
+			}
+
+			return true
+		}
+
+		if it.last {
+			break
+		}
+
+		it.fetch()
+	}
+
+	it.tx = nil
+
+	return false
+}
+
+func (it *TransactionIterator) fetch() {
+	page, err := it.api.TransactionsGetListAfterContext(it.ctx, it.address, it.limit, it.after)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.page = page
+	it.index = 0
+	it.last = len(page) == 0 || (it.limit > 0 && len(page) < it.limit)
+
+	if len(page) != 0 {
+		it.after = page[len(page)-1].GetBase().ID
+	}
+}
+
+/**
+ * The current transaction
+ */
+func (it *TransactionIterator) Transaction() Transaction {
+	return it.tx
+}
+
+func (it *TransactionIterator) Err() error {
+	return it.err
 }
 
 type TransactionsUTXSizeResponse struct {
@@ -74,48 +185,109 @@ type TransactionsUTXSizeResponse struct {
 }
 
 func (api *API) TransactionsUTXSize() (*TransactionsUTXSizeResponse, error) {
+	return api.TransactionsUTXSizeContext(context.Background())
+}
+
+func (api *API) TransactionsUTXSizeContext(ctx context.Context) (*TransactionsUTXSizeResponse, error) {
 	var res *TransactionsUTXSizeResponse
 
 	path := fmt.Sprintf("/transactions/unconfirmed/size")
-	r, err := api.client.R().SetResult(&res).Get(path)
+	r, err := api.client.R().SetContext(ctx).SetResult(&res).Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get transaction")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
 	}
 
 	return res, nil
 }
 
-func (api *API) TransactionsUTXGet(id string) (*TransactionsGetResponse, error) {
-	var res *TransactionsGetResponse
+func (api *API) TransactionsUTXGet(id string) (Transaction, error) {
+	return api.TransactionsUTXGetContext(context.Background(), id)
+}
+
+func (api *API) TransactionsUTXGetContext(ctx context.Context, id string) (Transaction, error) {
+	var res json.RawMessage
 
 	path := fmt.Sprintf("/transactions/unconfirmed/info/%s", id)
-	r, err := api.client.R().SetResult(&res).Get(path)
+	r, err := api.client.R().SetContext(ctx).SetResult(&res).Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get transaction")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
 	}
 
-	return res, nil
+	return DecodeTransaction(res)
+}
+
+func (api *API) TransactionsUTXGetList() ([]Transaction, error) {
+	return api.TransactionsUTXGetListContext(context.Background())
 }
 
-func (api *API) TransactionsUTXGetList() ([][]*TransactionsGetListResponseItem, error) {
-	var res [][]*TransactionsGetListResponseItem
+func (api *API) TransactionsUTXGetListContext(ctx context.Context) ([]Transaction, error) {
+	var res []json.RawMessage
 
 	path := fmt.Sprintf("/transactions/unconfirmed")
-	r, err := api.client.R().SetResult(&res).Get(path)
+	r, err := api.client.R().SetContext(ctx).SetResult(&res).Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get transaction")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
+	}
+
+	return decodeTransactionList(res)
+}
+
+type TransactionsCalculateFeeResponse struct {
+	FeeAssetID *string `json:"feeAssetId"`
+	FeeAmount  int64   `json:"feeAmount"`
+}
+
+/**
+ * Let the node calculate the minimum fee of the transaction, the sender must be set
+ */
+func (api *API) TransactionsCalculateFee(tx Transaction) (int64, error) {
+	return api.TransactionsCalculateFeeContext(context.Background(), tx)
+}
+
+func (api *API) TransactionsCalculateFeeContext(ctx context.Context, tx Transaction) (int64, error) {
+	res := new(TransactionsCalculateFeeResponse)
+
+	path := fmt.Sprintf("/transactions/calculateFee")
+	r, err := api.client.R().SetContext(ctx).SetBody(tx).SetResult(res).Post(path)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to calculate fee")
+	}
+
+	if r.IsError() {
+		return 0, newAPIError(path, r)
+	}
+
+	return res.FeeAmount, nil
+}
+
+func (api *API) TransactionsBroadcast(tx Transaction) (Transaction, error) {
+	return api.TransactionsBroadcastContext(context.Background(), tx)
+}
+
+func (api *API) TransactionsBroadcastContext(ctx context.Context, tx Transaction) (Transaction, error) {
+	base := tx.GetBase()
+	res := newTransaction(base.Type, base.Version)
+
+	path := fmt.Sprintf("/transactions/broadcast")
+	r, err := api.client.R().SetContext(ctx).SetBody(tx).SetResult(res).Post(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to broadcast transaction")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
 	}
 
 	return res, nil
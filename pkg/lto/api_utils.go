@@ -1,6 +1,7 @@
 package lto
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -12,16 +13,20 @@ type UtilsTimeResponse struct {
 }
 
 func (api *API) UtilsTime() (*UtilsTimeResponse, error) {
+	return api.UtilsTimeContext(context.Background())
+}
+
+func (api *API) UtilsTimeContext(ctx context.Context) (*UtilsTimeResponse, error) {
 	res := new(UtilsTimeResponse)
 
 	path := fmt.Sprintf("/utils/time")
-	r, err := api.client.R().SetResult(res).Get(path)
+	r, err := api.client.R().SetContext(ctx).SetResult(res).Get(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get time")
 	}
 
 	if r.IsError() {
-		return nil, errors.New(string(r.Body()))
+		return nil, newAPIError(path, r)
 	}
 
 	return res, nil
@@ -31,24 +36,59 @@ type UtilsCompileResponse struct {
 	Script string `json:"script"`
 }
 
+/**
+ * Deprecated: UtilsCompile returns an *APIError with the same code and message, use that instead
+ */
 type UtilsCompileResponseError struct {
 	Error   int    `json:"error"`
 	Message string `json:"message"`
 }
 
 func (api *API) UtilsCompile(code string) (string, error) {
+	return api.UtilsCompileContext(context.Background(), code)
+}
+
+func (api *API) UtilsCompileContext(ctx context.Context, code string) (string, error) {
 	res := new(UtilsCompileResponse)
 
 	path := fmt.Sprintf("/utils/script/compile")
-	r, err := api.client.R().SetBody(code).SetResult(res).Post(path)
+	r, err := api.client.R().SetContext(ctx).SetBody(code).SetResult(res).Post(path)
 
 	if err != nil {
 		return "", errors.Wrap(err, "failed to compile script")
 	}
 
 	if r.IsError() {
-		return "", errors.New(string(r.Body()))
+		return "", newAPIError(path, r)
 	}
 
 	return res.Script, nil
 }
+
+type UtilsEstimateResponse struct {
+	Script     string `json:"script"`
+	ScriptText string `json:"scriptText"`
+	Complexity int64  `json:"complexity"`
+	ExtraFee   int64  `json:"extraFee"`
+}
+
+func (api *API) UtilsEstimate(script string) (*UtilsEstimateResponse, error) {
+	return api.UtilsEstimateContext(context.Background(), script)
+}
+
+func (api *API) UtilsEstimateContext(ctx context.Context, script string) (*UtilsEstimateResponse, error) {
+	res := new(UtilsEstimateResponse)
+
+	path := fmt.Sprintf("/utils/script/estimate")
+	r, err := api.client.R().SetContext(ctx).SetBody(script).SetResult(res).Post(path)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to estimate script")
+	}
+
+	if r.IsError() {
+		return nil, newAPIError(path, r)
+	}
+
+	return res, nil
+}
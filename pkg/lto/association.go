@@ -0,0 +1,476 @@
+package lto
+
+import (
+	"encoding/json"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+const AssociationFee int64 = 100000000
+const AssociationDefaultVersion byte = 3
+const MaxAssociationHashLength = 64
+
+type associationParams struct {
+	version         byte
+	network         Network
+	recipient       []byte
+	associationType int32
+	hash            []byte
+	expires         int64
+	fee             int64
+	timestamp       int64
+	fees            *FeeCalculator
+}
+
+func NewAssociation() *associationParams {
+	return &associationParams{
+		version:   AssociationDefaultVersion,
+		timestamp: getTimestamp(),
+	}
+}
+
+func (p *associationParams) Create() (*Association, error) {
+	if p.version != 1 && p.version != 3 {
+		return nil, errors.Errorf("unsupported association version %d", p.version)
+	}
+
+	err := validateAssociation(p.network, p.recipient, p.hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.expires != 0 && p.version < 3 {
+		return nil, errors.New("expiry is only supported from association version 3")
+	}
+
+	tx := &Association{
+		TransactionBase: TransactionBase{
+			Type:      TransactionTypeAssociation,
+			Version:   p.version,
+			Network:   p.network,
+			Fee:       p.fee,
+			Timestamp: p.timestamp,
+		},
+		Recipient:       p.recipient,
+		AssociationType: p.associationType,
+		Hash:            p.hash,
+		Expires:         p.expires,
+	}
+
+	err = fillFee(tx, p.fees)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (p *associationParams) WithRecipient(recipient []byte) *associationParams {
+	p.recipient = recipient
+	return p
+}
+
+func (p *associationParams) WithAssociationType(associationType int32) *associationParams {
+	p.associationType = associationType
+	return p
+}
+
+func (p *associationParams) WithHash(hash []byte) *associationParams {
+	p.hash = hash
+	return p
+}
+
+func (p *associationParams) WithExpires(expires int64) *associationParams {
+	p.expires = expires
+	return p
+}
+
+func (p *associationParams) WithFee(fee int64) *associationParams {
+	p.fee = fee
+	return p
+}
+
+func (p *associationParams) WithTimestamp(timestamp int64) *associationParams {
+	p.timestamp = timestamp
+	return p
+}
+
+func (p *associationParams) WithVersion(version byte) *associationParams {
+	p.version = version
+	return p
+}
+
+func (p *associationParams) WithNetwork(network Network) *associationParams {
+	p.network = network
+	return p
+}
+
+func (p *associationParams) WithNetworkConfig(config *Config) *associationParams {
+	p.network = config.Network
+	p.fees = NewFeeCalculator(config.BasicConfig)
+	return p
+}
+
+func validateAssociation(network Network, recipient []byte, hash []byte) error {
+	if len(recipient) != crypto.AddressLength {
+		return errors.New("invalid recipient")
+	}
+
+	if network != 0 && !crypto.IsValidAddress(recipient, byte(network)) {
+		return errors.New("recipient is not a valid address for the network")
+	}
+
+	if len(hash) > MaxAssociationHashLength {
+		return errors.Errorf("hash must not be longer than %d bytes", MaxAssociationHashLength)
+	}
+
+	return nil
+}
+
+type Association struct {
+	TransactionBase
+
+	/**
+	 * Address of the party the sender is associated with
+	 */
+	Recipient []byte
+
+	AssociationType int32
+
+	/**
+	 * Optional hash further describing the association
+	 */
+	Hash []byte
+
+	/**
+	 * Timestamp at which the association expires, 0 for no expiry
+	 */
+	Expires int64
+}
+
+func (t *Association) GetBodyBytes() ([]byte, error) {
+	if len(t.SenderPublicKey) == 0 {
+		return nil, errors.New("first set sender before creating body bytes")
+	}
+
+	if t.Network == 0 {
+		return nil, errors.New("network unknown")
+	}
+
+	switch t.Version {
+	case 1:
+		values := []interface{}{
+			t.Type,
+			t.Version,
+			t.Network,
+			t.SenderPublicKey,
+			t.Recipient,
+			t.AssociationType,
+		}
+		values = append(values, optionalBytesValues(t.Hash)...)
+		values = append(values, t.Timestamp, t.Fee)
+
+		return writeBinary(values...)
+	case 3:
+		return writeBinary(
+			t.Type,
+			t.Version,
+			t.Network,
+			t.Timestamp,
+			t.SenderKeyType,
+			t.SenderPublicKey,
+			t.Fee,
+			t.Recipient,
+			t.AssociationType,
+			t.Expires,
+			uint16(len(t.Hash)),
+			t.Hash,
+		)
+	default:
+		return nil, errors.Errorf("unsupported association version %d", t.Version)
+	}
+}
+
+func optionalBytesValues(b []byte) []interface{} {
+	if len(b) == 0 {
+		return []interface{}{byte(0)}
+	}
+
+	return []interface{}{byte(1), uint16(len(b)), b}
+}
+
+func (t *Association) SignWith(account *Account) (*Association, error) {
+	err := account.SignTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *Association) SponsorWith(account *Account) (*Association, error) {
+	err := account.SponsorTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type associationJSON struct {
+	transactionBaseJSON
+	Recipient       string `json:"recipient"`
+	AssociationType int32  `json:"associationType"`
+	Hash            string `json:"hash,omitempty"`
+	Expires         int64  `json:"expires,omitempty"`
+}
+
+func (t *Association) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&associationJSON{
+		transactionBaseJSON: t.toJSON(),
+		Recipient:           crypto.Base58Encode(t.Recipient),
+		AssociationType:     t.AssociationType,
+		Hash:                crypto.Base58Encode(t.Hash),
+		Expires:             t.Expires,
+	})
+}
+
+func (t *Association) UnmarshalJSON(data []byte) error {
+	res := new(associationJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	err = t.fromJSON(&res.transactionBaseJSON)
+	if err != nil {
+		return err
+	}
+
+	t.Recipient, err = decodeBase58("recipient", res.Recipient)
+	if err != nil {
+		return err
+	}
+
+	t.Hash, err = decodeBase58("hash", res.Hash)
+	if err != nil {
+		return err
+	}
+
+	t.AssociationType = res.AssociationType
+	t.Expires = res.Expires
+
+	return nil
+}
+
+const RevokeAssociationFee int64 = 100000000
+const RevokeAssociationDefaultVersion byte = 3
+
+type revokeAssociationParams struct {
+	version         byte
+	network         Network
+	recipient       []byte
+	associationType int32
+	hash            []byte
+	fee             int64
+	timestamp       int64
+	fees            *FeeCalculator
+}
+
+func NewRevokeAssociation() *revokeAssociationParams {
+	return &revokeAssociationParams{
+		version:   RevokeAssociationDefaultVersion,
+		timestamp: getTimestamp(),
+	}
+}
+
+func (p *revokeAssociationParams) Create() (*RevokeAssociation, error) {
+	if p.version != 1 && p.version != 3 {
+		return nil, errors.Errorf("unsupported revoke association version %d", p.version)
+	}
+
+	err := validateAssociation(p.network, p.recipient, p.hash)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &RevokeAssociation{
+		TransactionBase: TransactionBase{
+			Type:      TransactionTypeRevokeAssociation,
+			Version:   p.version,
+			Network:   p.network,
+			Fee:       p.fee,
+			Timestamp: p.timestamp,
+		},
+		Recipient:       p.recipient,
+		AssociationType: p.associationType,
+		Hash:            p.hash,
+	}
+
+	err = fillFee(tx, p.fees)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (p *revokeAssociationParams) WithRecipient(recipient []byte) *revokeAssociationParams {
+	p.recipient = recipient
+	return p
+}
+
+func (p *revokeAssociationParams) WithAssociationType(associationType int32) *revokeAssociationParams {
+	p.associationType = associationType
+	return p
+}
+
+func (p *revokeAssociationParams) WithHash(hash []byte) *revokeAssociationParams {
+	p.hash = hash
+	return p
+}
+
+func (p *revokeAssociationParams) WithFee(fee int64) *revokeAssociationParams {
+	p.fee = fee
+	return p
+}
+
+func (p *revokeAssociationParams) WithTimestamp(timestamp int64) *revokeAssociationParams {
+	p.timestamp = timestamp
+	return p
+}
+
+func (p *revokeAssociationParams) WithVersion(version byte) *revokeAssociationParams {
+	p.version = version
+	return p
+}
+
+func (p *revokeAssociationParams) WithNetwork(network Network) *revokeAssociationParams {
+	p.network = network
+	return p
+}
+
+func (p *revokeAssociationParams) WithNetworkConfig(config *Config) *revokeAssociationParams {
+	p.network = config.Network
+	p.fees = NewFeeCalculator(config.BasicConfig)
+	return p
+}
+
+type RevokeAssociation struct {
+	TransactionBase
+
+	/**
+	 * Address of the party the association is revoked for
+	 */
+	Recipient []byte
+
+	AssociationType int32
+
+	Hash []byte
+}
+
+func (t *RevokeAssociation) GetBodyBytes() ([]byte, error) {
+	if len(t.SenderPublicKey) == 0 {
+		return nil, errors.New("first set sender before creating body bytes")
+	}
+
+	if t.Network == 0 {
+		return nil, errors.New("network unknown")
+	}
+
+	switch t.Version {
+	case 1:
+		values := []interface{}{
+			t.Type,
+			t.Version,
+			t.Network,
+			t.SenderPublicKey,
+			t.Recipient,
+			t.AssociationType,
+		}
+		values = append(values, optionalBytesValues(t.Hash)...)
+		values = append(values, t.Timestamp, t.Fee)
+
+		return writeBinary(values...)
+	case 3:
+		return writeBinary(
+			t.Type,
+			t.Version,
+			t.Network,
+			t.Timestamp,
+			t.SenderKeyType,
+			t.SenderPublicKey,
+			t.Fee,
+			t.Recipient,
+			t.AssociationType,
+			uint16(len(t.Hash)),
+			t.Hash,
+		)
+	default:
+		return nil, errors.Errorf("unsupported revoke association version %d", t.Version)
+	}
+}
+
+func (t *RevokeAssociation) SignWith(account *Account) (*RevokeAssociation, error) {
+	err := account.SignTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *RevokeAssociation) SponsorWith(account *Account) (*RevokeAssociation, error) {
+	err := account.SponsorTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type revokeAssociationJSON struct {
+	transactionBaseJSON
+	Recipient       string `json:"recipient"`
+	AssociationType int32  `json:"associationType"`
+	Hash            string `json:"hash,omitempty"`
+}
+
+func (t *RevokeAssociation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&revokeAssociationJSON{
+		transactionBaseJSON: t.toJSON(),
+		Recipient:           crypto.Base58Encode(t.Recipient),
+		AssociationType:     t.AssociationType,
+		Hash:                crypto.Base58Encode(t.Hash),
+	})
+}
+
+func (t *RevokeAssociation) UnmarshalJSON(data []byte) error {
+	res := new(revokeAssociationJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	err = t.fromJSON(&res.transactionBaseJSON)
+	if err != nil {
+		return err
+	}
+
+	t.Recipient, err = decodeBase58("recipient", res.Recipient)
+	if err != nil {
+		return err
+	}
+
+	t.Hash, err = decodeBase58("hash", res.Hash)
+	if err != nil {
+		return err
+	}
+
+	t.AssociationType = res.AssociationType
+
+	return nil
+}
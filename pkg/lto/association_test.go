@@ -0,0 +1,199 @@
+package lto_test
+
+import (
+	"testing"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestAssociation_GetBodyBytes(t *testing.T) {
+	publicKey := crypto.Base58Decode("FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y")
+	recipient := crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")
+	hash := crypto.Sha256([]byte("kyc"))
+
+	type fields struct {
+		version byte
+		hash    []byte
+		expires int64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "should serialize a v1 association without hash",
+			fields: fields{
+				version: 1,
+			},
+			want: concat(
+				[]byte{16, 1, 'T'},
+				publicKey,
+				recipient,
+				[]byte{0, 0, 0, 42},
+				[]byte{0},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{0, 0, 0, 0, 0x05, 0xf5, 0xe1, 0x00},
+			),
+		},
+		{
+			name: "should serialize a v1 association with hash",
+			fields: fields{
+				version: 1,
+				hash:    hash,
+			},
+			want: concat(
+				[]byte{16, 1, 'T'},
+				publicKey,
+				recipient,
+				[]byte{0, 0, 0, 42},
+				[]byte{1, 0, 32},
+				hash,
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{0, 0, 0, 0, 0x05, 0xf5, 0xe1, 0x00},
+			),
+		},
+		{
+			name: "should serialize a v3 association",
+			fields: fields{
+				version: 3,
+				hash:    hash,
+				expires: 1519862400000,
+			},
+			want: concat(
+				[]byte{16, 3, 'T'},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{1},
+				publicKey,
+				[]byte{0, 0, 0, 0, 0x05, 0xf5, 0xe1, 0x00},
+				recipient,
+				[]byte{0, 0, 0, 42},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{0, 32},
+				hash,
+			),
+		},
+		{
+			name: "should throw an error for expiry on v1",
+			fields: fields{
+				version: 1,
+				expires: 1519862400000,
+			},
+			wantErr: true,
+		},
+		{
+			name: "should throw an error for a long hash",
+			fields: fields{
+				version: 3,
+				hash:    make([]byte, 65),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := lto.NewAccount().
+				FromPrivateKey(crypto.Base58Decode("wJ4WH8dD88fSkNdFQRjaAhjFUZzZhV5yiDLDwNUnp6bYwRXrvWV8MJhQ9HL9uqMDG1n7XpTGZx7PafqaayQV8Rp")).
+				WithNetwork(lto.NetworkTest).
+				Create()
+			require.NoError(t, err)
+
+			tx, err := lto.NewAssociation().
+				WithVersion(tt.fields.version).
+				WithRecipient(recipient).
+				WithAssociationType(42).
+				WithHash(tt.fields.hash).
+				WithExpires(tt.fields.expires).
+				WithTimestamp(1519862400000).
+				Create()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			tx, err = tx.SignWith(a)
+			require.NoError(t, err)
+
+			body, err := tx.GetBodyBytes()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, body)
+
+			valid, err := a.Verify(tx.Proofs[0], body)
+			require.NoError(t, err)
+			require.True(t, valid)
+		})
+	}
+}
+
+func TestRevokeAssociation_GetBodyBytes(t *testing.T) {
+	publicKey := crypto.Base58Decode("FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y")
+	recipient := crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")
+	hash := crypto.Sha256([]byte("kyc"))
+
+	tests := []struct {
+		name    string
+		version byte
+		want    []byte
+	}{
+		{
+			name:    "should serialize a v1 revoke association",
+			version: 1,
+			want: concat(
+				[]byte{17, 1, 'T'},
+				publicKey,
+				recipient,
+				[]byte{0, 0, 0, 42},
+				[]byte{1, 0, 32},
+				hash,
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{0, 0, 0, 0, 0x05, 0xf5, 0xe1, 0x00},
+			),
+		},
+		{
+			name:    "should serialize a v3 revoke association",
+			version: 3,
+			want: concat(
+				[]byte{17, 3, 'T'},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{1},
+				publicKey,
+				[]byte{0, 0, 0, 0, 0x05, 0xf5, 0xe1, 0x00},
+				recipient,
+				[]byte{0, 0, 0, 42},
+				[]byte{0, 32},
+				hash,
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := lto.NewAccount().
+				FromPrivateKey(crypto.Base58Decode("wJ4WH8dD88fSkNdFQRjaAhjFUZzZhV5yiDLDwNUnp6bYwRXrvWV8MJhQ9HL9uqMDG1n7XpTGZx7PafqaayQV8Rp")).
+				WithNetwork(lto.NetworkTest).
+				Create()
+			require.NoError(t, err)
+
+			tx, err := lto.NewRevokeAssociation().
+				WithVersion(tt.version).
+				WithRecipient(recipient).
+				WithAssociationType(42).
+				WithHash(hash).
+				WithTimestamp(1519862400000).
+				Create()
+			require.NoError(t, err)
+
+			tx, err = tx.SignWith(a)
+			require.NoError(t, err)
+
+			body, err := tx.GetBodyBytes()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, body)
+		})
+	}
+}
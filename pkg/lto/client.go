@@ -1,9 +1,10 @@
 package lto
 
 import (
+	"context"
 	cryptorand "crypto/rand"
+	"time"
 
-	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
 	"github.com/pkg/errors"
 )
 
@@ -22,6 +23,8 @@ type Network byte
 const NetworkMain Network = 'L'
 const NetworkTest Network = 'T'
 
+const DefaultTimeout = 30 * time.Second
+
 func DefaultBasicConfig() *BasicConfig {
 	return &BasicConfig{
 		RequestOffset:     0,
@@ -36,6 +39,12 @@ func DefaultMainNetConfig() *Config {
 		BasicConfig: DefaultBasicConfig(),
 		Network:     NetworkMain,
 		NodeAddress: "https://nodes.legalthings.one",
+		Timeout:     DefaultTimeout,
+
+		RetryCount:       DefaultRetryCount,
+		RetryWaitTime:    DefaultRetryWaitTime,
+		RetryMaxWaitTime: DefaultRetryMaxWaitTime,
+		NodeCooldown:     DefaultNodeCooldown,
 	}
 }
 
@@ -44,13 +53,20 @@ func DefaultTestNetConfig() *Config {
 		BasicConfig: DefaultBasicConfig(),
 		Network:     NetworkTest,
 		NodeAddress: "https://testnet.legalthings.one",
+		Timeout:     DefaultTimeout,
+
+		RetryCount:       DefaultRetryCount,
+		RetryWaitTime:    DefaultRetryWaitTime,
+		RetryMaxWaitTime: DefaultRetryMaxWaitTime,
+		NodeCooldown:     DefaultNodeCooldown,
 	}
 }
 
 type clientParams struct {
-	config      *Config
-	network     Network
-	nodeAddress string
+	config        *Config
+	network       Network
+	nodeAddress   string
+	nodeAddresses []string
 }
 
 func NewClient() *clientParams {
@@ -75,6 +91,10 @@ func (p *clientParams) Create() (*Client, error) {
 		p.config.NodeAddress = p.nodeAddress
 	}
 
+	if len(p.nodeAddresses) != 0 {
+		p.config.NodeAddresses = p.nodeAddresses
+	}
+
 	api, err := NewAPI(p.config)
 	if err != nil {
 		return nil, err
@@ -83,6 +103,7 @@ func (p *clientParams) Create() (*Client, error) {
 	return &Client{
 		Config: p.config,
 		API:    api,
+		Fees:   NewFeeCalculator(p.config.BasicConfig),
 	}, nil
 }
 
@@ -92,6 +113,15 @@ func (p *clientParams) WithNodeAddress(nodeAddress string) *clientParams {
 	return p
 }
 
+/**
+ * Nodes to fail over to when the node address is unavailable
+ */
+func (p *clientParams) WithNodeAddresses(nodeAddresses ...string) *clientParams {
+	p.nodeAddresses = nodeAddresses
+
+	return p
+}
+
 func (p *clientParams) WithNetwork(network Network) *clientParams {
 	p.network = network
 
@@ -106,6 +136,7 @@ func (p *clientParams) WithNetworkConfig(config *Config) *clientParams {
 
 type Client struct {
 	Config *Config
+	Fees   *FeeCalculator
 	*API
 }
 
@@ -113,6 +144,46 @@ type Config struct {
 	*BasicConfig
 	Network     Network
 	NodeAddress string
+
+	/**
+	 * Maximum duration of a request to the node, 0 for no timeout.
+	 * Use the Context variants of the API methods for a deadline per request.
+	 */
+	Timeout time.Duration
+
+	/**
+	 * Nodes used besides the node address, a node that fails a request or health check is skipped for the node cooldown
+	 */
+	NodeAddresses []string
+	NodeSelection NodeSelection
+	NodeCooldown  time.Duration
+
+	/**
+	 * Number of times a failed GET request is retried, the wait time doubles after each retry up to the max.
+	 * Other requests are never retried, as they may not be idempotent.
+	 */
+	RetryCount       int
+	RetryWaitTime    time.Duration
+	RetryMaxWaitTime time.Duration
+}
+
+/**
+ * The node address followed by the other node addresses, without duplicates
+ */
+func (c *Config) nodeAddresses() []string {
+	addresses := make([]string, 0, len(c.NodeAddresses)+1)
+	seen := make(map[string]bool, len(c.NodeAddresses)+1)
+
+	for _, address := range append([]string{c.NodeAddress}, c.NodeAddresses...) {
+		if address == "" || seen[address] {
+			continue
+		}
+
+		seen[address] = true
+		addresses = append(addresses, address)
+	}
+
+	return addresses
 }
 
 type BasicConfig struct {
@@ -120,14 +191,100 @@ type BasicConfig struct {
 	RequestLimit      int
 	MinimumSeedLength int
 	TimeDiff          int64
+
+	/**
+	 * Overrides of the default base fee and fee per item of transaction types
+	 */
+	BaseFees map[TransactionType]int64
+	VarFees  map[TransactionType]int64
 }
 
 func (c *Client) NewAccount() *accountParams {
 	return NewAccount().WithNetworkConfig(c.Config)
 }
 
-func (c *Client) IsValidAddress(address []byte) bool {
-	return crypto.IsValidAddress(address, byte(c.Config.Network))
+func (c *Client) NewTransfer() *transferParams {
+	return NewTransfer().WithNetworkConfig(c.Config)
+}
+
+func (c *Client) NewLease() *leaseParams {
+	return NewLease().WithNetworkConfig(c.Config)
+}
+
+func (c *Client) NewCancelLease() *cancelLeaseParams {
+	return NewCancelLease().WithNetworkConfig(c.Config)
+}
+
+func (c *Client) NewMassTransfer() *massTransferParams {
+	return NewMassTransfer().WithNetworkConfig(c.Config)
+}
+
+func (c *Client) NewData() *dataParams {
+	return NewData().WithNetworkConfig(c.Config)
+}
+
+func (c *Client) NewSetScript() *setScriptParams {
+	return NewSetScript().WithNetworkConfig(c.Config)
+}
+
+func (c *Client) NewAnchor() *anchorParams {
+	return NewAnchor().WithNetworkConfig(c.Config)
+}
+
+func (c *Client) NewAssociation() *associationParams {
+	return NewAssociation().WithNetworkConfig(c.Config)
+}
+
+func (c *Client) NewRevokeAssociation() *revokeAssociationParams {
+	return NewRevokeAssociation().WithNetworkConfig(c.Config)
+}
+
+func (c *Client) NewSponsorship() *sponsorshipParams {
+	return NewSponsorship().WithNetworkConfig(c.Config)
+}
+
+func (c *Client) NewCancelSponsorship() *cancelSponsorshipParams {
+	return NewCancelSponsorship().WithNetworkConfig(c.Config)
+}
+
+/**
+ * Calculate the fee of the transaction and cross-check it with the fee calculated by the node.
+ * An error is returned if the fee schedule of the client doesn't match the node.
+ */
+func (c *Client) EstimateFee(tx Transaction) (int64, error) {
+	return c.EstimateFeeContext(context.Background(), tx)
+}
+
+func (c *Client) EstimateFeeContext(ctx context.Context, tx Transaction) (int64, error) {
+	fee, err := c.Fees.Calculate(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	nodeFee, err := c.TransactionsCalculateFeeContext(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	if fee != nodeFee {
+		return 0, errors.Errorf("calculated fee %d doesn't match the fee %d of the node", fee, nodeFee)
+	}
+
+	return fee, nil
+}
+
+/**
+ * Check that the address is valid and belongs to the network of the client
+ */
+func (c *Client) IsValidAddress(address Address) bool {
+	return address.IsValid() && address.Network() == c.Config.Network
+}
+
+/**
+ * Decode a base58 address of the network of the client
+ */
+func (c *Client) ParseAddress(s string) (Address, error) {
+	return ParseNetworkAddress(s, c.Config.Network)
 }
 
 /**
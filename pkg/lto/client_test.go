@@ -260,7 +260,7 @@ func TestLTO_IsValidAddress(t *testing.T) {
 		Network Network
 	}
 	type args struct {
-		address []byte
+		address Address
 	}
 	tests := []struct {
 		name   string
@@ -288,6 +288,16 @@ func TestLTO_IsValidAddress(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "should return false for an address of another network",
+			fields: fields{
+				Network: NetworkTest,
+			},
+			args: args{
+				address: crypto.Base58Decode("3JmCa4jLVv7Yn2XkCnBUGsa7WNFVEMxAfWe"),
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
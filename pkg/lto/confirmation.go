@@ -0,0 +1,193 @@
+package lto
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const DefaultConfirmations int64 = 1
+const DefaultMinPollInterval = time.Second
+const DefaultMaxPollInterval = 30 * time.Second
+
+/**
+ * Returned when a transaction disappears from the chain after it was included in a block
+ */
+type ReorgError struct {
+	TransactionID string
+
+	/**
+	 * Height of the block the transaction was last seen in
+	 */
+	Height int64
+}
+
+func (e *ReorgError) Error() string {
+	return fmt.Sprintf("transaction %s disappeared from block %d due to a reorg", e.TransactionID, e.Height)
+}
+
+type confirmationTrackerParams struct {
+	api             *API
+	confirmations   int64
+	minPollInterval time.Duration
+	maxPollInterval time.Duration
+}
+
+func NewConfirmationTracker(api *API) *confirmationTrackerParams {
+	return &confirmationTrackerParams{
+		api:             api,
+		confirmations:   DefaultConfirmations,
+		minPollInterval: DefaultMinPollInterval,
+		maxPollInterval: DefaultMaxPollInterval,
+	}
+}
+
+func (p *confirmationTrackerParams) Create() (*ConfirmationTracker, error) {
+	if p.api == nil {
+		return nil, errors.New("no api set")
+	}
+
+	if p.confirmations < 1 {
+		return nil, errors.New("confirmations must be at least 1")
+	}
+
+	if p.minPollInterval <= 0 || p.maxPollInterval < p.minPollInterval {
+		return nil, errors.New("invalid poll interval")
+	}
+
+	return &ConfirmationTracker{
+		api:             p.api,
+		confirmations:   p.confirmations,
+		minPollInterval: p.minPollInterval,
+		maxPollInterval: p.maxPollInterval,
+	}, nil
+}
+
+/**
+ * Number of blocks the transaction must be buried in, a transaction in the last block has 1 confirmation
+ */
+func (p *confirmationTrackerParams) WithConfirmations(confirmations int64) *confirmationTrackerParams {
+	p.confirmations = confirmations
+	return p
+}
+
+/**
+ * The poll interval starts at min and doubles after each poll up to max
+ */
+func (p *confirmationTrackerParams) WithPollInterval(min time.Duration, max time.Duration) *confirmationTrackerParams {
+	p.minPollInterval = min
+	p.maxPollInterval = max
+	return p
+}
+
+type ConfirmationTracker struct {
+	api             *API
+	confirmations   int64
+	minPollInterval time.Duration
+	maxPollInterval time.Duration
+}
+
+type Confirmation struct {
+	Transaction Transaction
+
+	/**
+	 * Height of the block containing the transaction
+	 */
+	Height        int64
+	Confirmations int64
+}
+
+/**
+ * Returned when a transaction is no longer unconfirmed but didn't make it into a block
+ */
+var ErrTransactionDropped = errors.New("transaction was dropped from the unconfirmed pool")
+
+type confirmationState struct {
+	seenHeight      int64
+	seenUnconfirmed bool
+}
+
+/**
+ * Block until the transaction has enough confirmations, the context is done or the transaction is lost
+ */
+func (c *ConfirmationTracker) Wait(ctx context.Context, id string) (*Confirmation, error) {
+	interval := c.minPollInterval
+	state := new(confirmationState)
+
+	for {
+		confirmation, err := c.poll(ctx, id, state)
+		if err != nil && ctx.Err() != nil {
+			return nil, errors.Wrapf(ctx.Err(), "stopped waiting for confirmation of transaction %s", id)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if confirmation != nil && confirmation.Confirmations >= c.confirmations {
+			return confirmation, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrapf(ctx.Err(), "stopped waiting for confirmation of transaction %s", id)
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > c.maxPollInterval {
+			interval = c.maxPollInterval
+		}
+	}
+}
+
+/**
+ * Get the confirmation of the transaction, nil if it isn't in a block yet
+ */
+func (c *ConfirmationTracker) poll(ctx context.Context, id string, state *confirmationState) (*Confirmation, error) {
+	tx, err := c.api.TransactionsGetContext(ctx, id)
+	if IsNotFound(err) {
+		if state.seenHeight != 0 {
+			return nil, &ReorgError{TransactionID: id, Height: state.seenHeight}
+		}
+
+		_, err = c.api.TransactionsUTXGetContext(ctx, id)
+		if err == nil {
+			state.seenUnconfirmed = true
+			return nil, nil
+		}
+
+		if !IsNotFound(err) {
+			return nil, err
+		}
+
+		if !state.seenUnconfirmed {
+			return nil, nil
+		}
+
+		// the transaction may have been included in a block in the meantime
+		tx, err = c.api.TransactionsGetContext(ctx, id)
+		if IsNotFound(err) {
+			return nil, errors.Wrapf(ErrTransactionDropped, "transaction %s", id)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := c.api.BlocksHeightContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state.seenHeight = tx.GetBase().Height
+
+	return &Confirmation{
+		Transaction:   tx,
+		Height:        state.seenHeight,
+		Confirmations: height.Height - state.seenHeight + 1,
+	}, nil
+}
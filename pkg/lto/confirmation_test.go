@@ -0,0 +1,208 @@
+package lto_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+const confirmationTxID = "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU"
+
+type fakeChain struct {
+	sync.Mutex
+	polls       int
+	height      int64
+	txHeight    int64
+	unconfirmed bool
+
+	/**
+	 * Called on every poll of the transaction to advance the chain
+	 */
+	advance func(c *fakeChain)
+}
+
+func (c *fakeChain) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.Lock()
+	defer c.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.URL.Path == "/transactions/info/"+confirmationTxID {
+		c.polls++
+		if c.advance != nil {
+			c.advance(c)
+		}
+	}
+
+	tx := fmt.Sprintf(`{
+		"type": 15,
+		"version": 3,
+		"id": "%s",
+		"sender": "3MyuPwbiobZFnZzrtyY8pkaHoQHYmyQxxY1",
+		"senderKeyType": "ed25519",
+		"senderPublicKey": "GjSacB6a5DFNEHjDSmn724QsrRStKYzkahPH67wyrhAY",
+		"fee": 35000000,
+		"timestamp": 1519862400000,
+		"anchors": [],
+		"proofs": [],
+		"height": %d
+	}`, confirmationTxID, c.txHeight)
+
+	switch r.URL.Path {
+	case "/transactions/info/" + confirmationTxID:
+		if c.txHeight == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status": "error", "details": "Transaction is not in blockchain"}`))
+			return
+		}
+		_, _ = w.Write([]byte(tx))
+	case "/transactions/unconfirmed/info/" + confirmationTxID:
+		if !c.unconfirmed {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status": "error", "details": "Transaction is not in UTX"}`))
+			return
+		}
+		_, _ = w.Write([]byte(tx))
+	case "/blocks/height":
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"height": %d}`, c.height)))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestConfirmationTracker_Wait(t *testing.T) {
+	tests := []struct {
+		name          string
+		confirmations int64
+		chain         *fakeChain
+		timeout       time.Duration
+		wantHeight    int64
+		wantErr       func(err error) bool
+	}{
+		{
+			name:          "should wait until the transaction has enough confirmations",
+			confirmations: 3,
+			chain: &fakeChain{
+				height:      9,
+				unconfirmed: true,
+				advance: func(c *fakeChain) {
+					if c.polls == 3 {
+						c.unconfirmed = false
+						c.height = 10
+						c.txHeight = 10
+					}
+					if c.polls > 3 {
+						c.height++
+					}
+				},
+			},
+			wantHeight: 10,
+		},
+		{
+			name:          "should report a reorg when the transaction disappears from the chain",
+			confirmations: 5,
+			chain: &fakeChain{
+				height:   10,
+				txHeight: 10,
+				advance: func(c *fakeChain) {
+					if c.polls == 3 {
+						c.txHeight = 0
+						c.unconfirmed = true
+					}
+				},
+			},
+			wantErr: func(err error) bool {
+				reorg, ok := errors.Cause(err).(*lto.ReorgError)
+				return ok && reorg.Height == 10
+			},
+		},
+		{
+			name:          "should report a transaction dropped from the unconfirmed pool",
+			confirmations: 1,
+			chain: &fakeChain{
+				height:      10,
+				unconfirmed: true,
+				advance: func(c *fakeChain) {
+					if c.polls == 3 {
+						c.unconfirmed = false
+					}
+				},
+			},
+			wantErr: func(err error) bool {
+				return errors.Cause(err) == lto.ErrTransactionDropped
+			},
+		},
+		{
+			name:          "should stop at the deadline of the context",
+			confirmations: 1,
+			chain: &fakeChain{
+				height:      10,
+				unconfirmed: true,
+			},
+			timeout: 20 * time.Millisecond,
+			wantErr: func(err error) bool {
+				return errors.Cause(err) == context.DeadlineExceeded
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.chain)
+			defer server.Close()
+
+			config := lto.DefaultTestNetConfig()
+			config.NodeAddress = server.URL
+
+			api, err := lto.NewAPI(config)
+			require.NoError(t, err)
+
+			tracker, err := lto.NewConfirmationTracker(api).
+				WithConfirmations(tt.confirmations).
+				WithPollInterval(time.Millisecond, 4*time.Millisecond).
+				Create()
+			require.NoError(t, err)
+
+			timeout := tt.timeout
+			if timeout == 0 {
+				timeout = 5 * time.Second
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			got, err := tracker.Wait(ctx, confirmationTxID)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.True(t, tt.wantErr(err), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantHeight, got.Height)
+			require.Equal(t, tt.confirmations, got.Confirmations)
+			require.Equal(t, confirmationTxID, got.Transaction.GetBase().ID)
+		})
+	}
+}
+
+func TestNewConfirmationTracker_Create(t *testing.T) {
+	api, err := lto.NewAPI(lto.DefaultTestNetConfig())
+	require.NoError(t, err)
+
+	_, err = lto.NewConfirmationTracker(api).WithConfirmations(0).Create()
+	require.Error(t, err)
+
+	_, err = lto.NewConfirmationTracker(api).WithPollInterval(time.Second, time.Millisecond).Create()
+	require.Error(t, err)
+
+	_, err = lto.NewConfirmationTracker(nil).Create()
+	require.Error(t, err)
+}
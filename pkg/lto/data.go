@@ -0,0 +1,418 @@
+package lto
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+const DataBaseFee int64 = 100000000
+const DataVarFee int64 = 10000000
+const DataDefaultVersion byte = 3
+
+const MaxDataEntries = 100
+const MaxDataKeyLength = 400
+const MaxDataValueLength = 32767
+const MaxDataBytes = 150 * 1024
+
+type DataEntryType byte
+
+const (
+	DataEntryTypeInteger DataEntryType = 0
+	DataEntryTypeBoolean DataEntryType = 1
+	DataEntryTypeBinary  DataEntryType = 2
+	DataEntryTypeString  DataEntryType = 3
+)
+
+func (t DataEntryType) String() string {
+	switch t {
+	case DataEntryTypeInteger:
+		return "integer"
+	case DataEntryTypeBoolean:
+		return "boolean"
+	case DataEntryTypeBinary:
+		return "binary"
+	case DataEntryTypeString:
+		return "string"
+	default:
+		return ""
+	}
+}
+
+func parseDataEntryType(s string) (DataEntryType, error) {
+	switch s {
+	case "integer":
+		return DataEntryTypeInteger, nil
+	case "boolean":
+		return DataEntryTypeBoolean, nil
+	case "binary":
+		return DataEntryTypeBinary, nil
+	case "string":
+		return DataEntryTypeString, nil
+	default:
+		return 0, errors.Errorf("unsupported data entry type %s", s)
+	}
+}
+
+type DataEntry struct {
+	Key  string
+	Type DataEntryType
+
+	/**
+	 * Value of the entry, an int64, bool, []byte or string depending on the type
+	 */
+	Value interface{}
+}
+
+func (e *DataEntry) validate() error {
+	if len(e.Key) == 0 {
+		return errors.New("data entry key must not be empty")
+	}
+
+	if len(e.Key) > MaxDataKeyLength {
+		return errors.Errorf("data entry key must not be longer than %d bytes", MaxDataKeyLength)
+	}
+
+	var ok bool
+
+	switch e.Type {
+	case DataEntryTypeInteger:
+		_, ok = e.Value.(int64)
+	case DataEntryTypeBoolean:
+		_, ok = e.Value.(bool)
+	case DataEntryTypeBinary:
+		var value []byte
+		value, ok = e.Value.([]byte)
+		if ok && len(value) > MaxDataValueLength {
+			return errors.Errorf("value of data entry %s must not be longer than %d bytes", e.Key, MaxDataValueLength)
+		}
+	case DataEntryTypeString:
+		var value string
+		value, ok = e.Value.(string)
+		if ok && len(value) > MaxDataValueLength {
+			return errors.Errorf("value of data entry %s must not be longer than %d bytes", e.Key, MaxDataValueLength)
+		}
+	default:
+		return errors.Errorf("unsupported data entry type %d", e.Type)
+	}
+
+	if !ok {
+		return errors.Errorf("value of data entry %s does not match type %s", e.Key, e.Type)
+	}
+
+	return nil
+}
+
+func (e *DataEntry) getBinaryValues() []interface{} {
+	values := []interface{}{uint16(len(e.Key)), []byte(e.Key), e.Type}
+
+	switch value := e.Value.(type) {
+	case int64:
+		values = append(values, value)
+	case bool:
+		if value {
+			values = append(values, byte(1))
+		} else {
+			values = append(values, byte(0))
+		}
+	case []byte:
+		values = append(values, uint16(len(value)), value)
+	case string:
+		values = append(values, uint16(len(value)), []byte(value))
+	}
+
+	return values
+}
+
+type dataEntryJSON struct {
+	Key   string          `json:"key"`
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+func (e *DataEntry) MarshalJSON() ([]byte, error) {
+	var value interface{}
+
+	switch e.Type {
+	case DataEntryTypeBinary:
+		binary, _ := e.Value.([]byte)
+		value = "base64:" + crypto.Base64Encode(binary)
+	default:
+		value = e.Value
+	}
+
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&dataEntryJSON{
+		Key:   e.Key,
+		Type:  e.Type.String(),
+		Value: valueJSON,
+	})
+}
+
+func (e *DataEntry) UnmarshalJSON(data []byte) error {
+	res := new(dataEntryJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	e.Key = res.Key
+	e.Type, err = parseDataEntryType(res.Type)
+	if err != nil {
+		return err
+	}
+
+	switch e.Type {
+	case DataEntryTypeInteger:
+		var value int64
+		err = json.Unmarshal(res.Value, &value)
+		e.Value = value
+	case DataEntryTypeBoolean:
+		var value bool
+		err = json.Unmarshal(res.Value, &value)
+		e.Value = value
+	case DataEntryTypeBinary:
+		var value string
+		err = json.Unmarshal(res.Value, &value)
+		if err == nil {
+			e.Value, err = crypto.Base64Decode(strings.TrimPrefix(value, "base64:"))
+		}
+	case DataEntryTypeString:
+		var value string
+		err = json.Unmarshal(res.Value, &value)
+		e.Value = value
+	}
+
+	return err
+}
+
+type dataParams struct {
+	version   byte
+	network   Network
+	entries   []*DataEntry
+	fee       int64
+	timestamp int64
+	fees      *FeeCalculator
+}
+
+func NewData() *dataParams {
+	return &dataParams{
+		version:   DataDefaultVersion,
+		timestamp: getTimestamp(),
+	}
+}
+
+func (p *dataParams) Create() (*Data, error) {
+	if p.version != 1 && p.version != 3 {
+		return nil, errors.Errorf("unsupported data version %d", p.version)
+	}
+
+	if len(p.entries) > MaxDataEntries {
+		return nil, errors.Errorf("a data transaction can hold at most %d entries", MaxDataEntries)
+	}
+
+	keys := make(map[string]bool, len(p.entries))
+	for _, entry := range p.entries {
+		err := entry.validate()
+		if err != nil {
+			return nil, err
+		}
+
+		if keys[entry.Key] {
+			return nil, errors.Errorf("duplicate data entry key %s", entry.Key)
+		}
+		keys[entry.Key] = true
+	}
+
+	tx := &Data{
+		TransactionBase: TransactionBase{
+			Type:      TransactionTypeData,
+			Version:   p.version,
+			Network:   p.network,
+			Fee:       p.fee,
+			Timestamp: p.timestamp,
+		},
+		Entries: p.entries,
+	}
+
+	dataBytes, err := tx.getEntriesBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dataBytes) > MaxDataBytes {
+		return nil, errors.Errorf("data must not be larger than %d bytes", MaxDataBytes)
+	}
+
+	err = fillFee(tx, p.fees)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (p *dataParams) WithInteger(key string, value int64) *dataParams {
+	return p.WithEntries(&DataEntry{Key: key, Type: DataEntryTypeInteger, Value: value})
+}
+
+func (p *dataParams) WithBoolean(key string, value bool) *dataParams {
+	return p.WithEntries(&DataEntry{Key: key, Type: DataEntryTypeBoolean, Value: value})
+}
+
+func (p *dataParams) WithBinary(key string, value []byte) *dataParams {
+	return p.WithEntries(&DataEntry{Key: key, Type: DataEntryTypeBinary, Value: value})
+}
+
+func (p *dataParams) WithString(key string, value string) *dataParams {
+	return p.WithEntries(&DataEntry{Key: key, Type: DataEntryTypeString, Value: value})
+}
+
+func (p *dataParams) WithEntries(entries ...*DataEntry) *dataParams {
+	p.entries = append(p.entries, entries...)
+	return p
+}
+
+func (p *dataParams) WithFee(fee int64) *dataParams {
+	p.fee = fee
+	return p
+}
+
+func (p *dataParams) WithTimestamp(timestamp int64) *dataParams {
+	p.timestamp = timestamp
+	return p
+}
+
+func (p *dataParams) WithVersion(version byte) *dataParams {
+	p.version = version
+	return p
+}
+
+func (p *dataParams) WithNetwork(network Network) *dataParams {
+	p.network = network
+	return p
+}
+
+func (p *dataParams) WithNetworkConfig(config *Config) *dataParams {
+	p.network = config.Network
+	p.fees = NewFeeCalculator(config.BasicConfig)
+	return p
+}
+
+type Data struct {
+	TransactionBase
+
+	Entries []*DataEntry
+}
+
+func (t *Data) getEntriesValues() []interface{} {
+	values := []interface{}{uint16(len(t.Entries))}
+
+	for _, entry := range t.Entries {
+		values = append(values, entry.getBinaryValues()...)
+	}
+
+	return values
+}
+
+func (t *Data) getEntriesBytes() ([]byte, error) {
+	return writeBinary(t.getEntriesValues()...)
+}
+
+func (t *Data) GetBodyBytes() ([]byte, error) {
+	if len(t.SenderPublicKey) == 0 {
+		return nil, errors.New("first set sender before creating body bytes")
+	}
+
+	var values []interface{}
+
+	switch t.Version {
+	case 1:
+		values = []interface{}{
+			t.Type,
+			t.Version,
+			t.SenderPublicKey,
+		}
+		values = append(values, t.getEntriesValues()...)
+		values = append(values, t.Timestamp, t.Fee)
+	case 3:
+		if t.Network == 0 {
+			return nil, errors.New("network unknown")
+		}
+
+		values = []interface{}{
+			t.Type,
+			t.Version,
+			t.Network,
+			t.Timestamp,
+			t.SenderKeyType,
+			t.SenderPublicKey,
+			t.Fee,
+		}
+		values = append(values, t.getEntriesValues()...)
+	default:
+		return nil, errors.Errorf("unsupported data version %d", t.Version)
+	}
+
+	return writeBinary(values...)
+}
+
+func (t *Data) SignWith(account *Account) (*Data, error) {
+	err := account.SignTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *Data) SponsorWith(account *Account) (*Data, error) {
+	err := account.SponsorTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type dataJSON struct {
+	transactionBaseJSON
+	Data []*DataEntry `json:"data"`
+}
+
+func (t *Data) MarshalJSON() ([]byte, error) {
+	entries := t.Entries
+	if entries == nil {
+		entries = []*DataEntry{}
+	}
+
+	return json.Marshal(&dataJSON{
+		transactionBaseJSON: t.toJSON(),
+		Data:                entries,
+	})
+}
+
+func (t *Data) UnmarshalJSON(data []byte) error {
+	res := new(dataJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	err = t.fromJSON(&res.transactionBaseJSON)
+	if err != nil {
+		return err
+	}
+
+	t.Entries = res.Data
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package lto_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestData_GetBodyBytes(t *testing.T) {
+	publicKey := crypto.Base58Decode("FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y")
+	entries := concat(
+		[]byte{0, 4},
+		[]byte{0, 1, 'a', 0, 0, 0, 0, 0, 0, 0, 0, 1},
+		[]byte{0, 1, 'b', 1, 1},
+		[]byte{0, 1, 'c', 2, 0, 2, 1, 2},
+		[]byte{0, 1, 'd', 3, 0, 2, 'h', 'i'},
+	)
+
+	tests := []struct {
+		name    string
+		version byte
+		want    []byte
+	}{
+		{
+			name:    "should serialize a v1 data transaction",
+			version: 1,
+			want: concat(
+				[]byte{12, 1},
+				publicKey,
+				entries,
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{0, 0, 0, 0, 0x06, 0x8e, 0x77, 0x80},
+			),
+		},
+		{
+			name:    "should serialize a v3 data transaction",
+			version: 3,
+			want: concat(
+				[]byte{12, 3, 'T'},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{1},
+				publicKey,
+				[]byte{0, 0, 0, 0, 0x06, 0x8e, 0x77, 0x80},
+				entries,
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := lto.NewAccount().
+				FromPrivateKey(crypto.Base58Decode("wJ4WH8dD88fSkNdFQRjaAhjFUZzZhV5yiDLDwNUnp6bYwRXrvWV8MJhQ9HL9uqMDG1n7XpTGZx7PafqaayQV8Rp")).
+				WithNetwork(lto.NetworkTest).
+				Create()
+			require.NoError(t, err)
+
+			tx, err := lto.NewData().
+				WithVersion(tt.version).
+				WithInteger("a", 1).
+				WithBoolean("b", true).
+				WithBinary("c", []byte{1, 2}).
+				WithString("d", "hi").
+				WithTimestamp(1519862400000).
+				Create()
+			require.NoError(t, err)
+			require.Equal(t, int64(110000000), tx.Fee)
+
+			tx, err = tx.SignWith(a)
+			require.NoError(t, err)
+
+			body, err := tx.GetBodyBytes()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, body)
+		})
+	}
+}
+
+func TestNewData_Create(t *testing.T) {
+	tooMany := lto.NewData()
+	for i := 0; i <= lto.MaxDataEntries; i++ {
+		tooMany.WithInteger(strconv.Itoa(i), int64(i))
+	}
+
+	tests := []struct {
+		name    string
+		params  interface{ Create() (*lto.Data, error) }
+		wantErr bool
+	}{
+		{
+			name:   "should create a data transaction without entries",
+			params: lto.NewData(),
+		},
+		{
+			name:    "should throw an error for a duplicate key",
+			params:  lto.NewData().WithInteger("a", 1).WithString("a", "b"),
+			wantErr: true,
+		},
+		{
+			name:    "should throw an error for an empty key",
+			params:  lto.NewData().WithInteger("", 1),
+			wantErr: true,
+		},
+		{
+			name:    "should throw an error when the value does not match the type",
+			params:  lto.NewData().WithEntries(&lto.DataEntry{Key: "a", Type: lto.DataEntryTypeInteger, Value: "1"}),
+			wantErr: true,
+		},
+		{
+			name:    "should throw an error for a value that is too long",
+			params:  lto.NewData().WithBinary("a", make([]byte, lto.MaxDataValueLength+1)),
+			wantErr: true,
+		},
+		{
+			name:    "should throw an error for too many entries",
+			params:  tooMany,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.params.Create()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestData_MarshalJSON(t *testing.T) {
+	a, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	tx, err := lto.NewData().
+		WithInteger("a", 1).
+		WithBoolean("b", true).
+		WithBinary("c", []byte{1, 2}).
+		WithString("d", "hi").
+		Create()
+	require.NoError(t, err)
+
+	tx, err = tx.SignWith(a)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(tx)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `{"key":"c","type":"binary","value":"base64:AQI="}`)
+
+	res := new(lto.Data)
+	err = json.Unmarshal(data, res)
+	require.NoError(t, err)
+	require.Equal(t, tx.Entries, res.Entries)
+	require.Equal(t, tx.ID, res.ID)
+}
@@ -0,0 +1,304 @@
+package lto
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+/**
+ * Portable container for signing an unsigned transaction on another machine, e.g. an air-gapped wallet
+ */
+type Envelope struct {
+	Transaction Transaction
+
+	/**
+	 * Body bytes of the transaction, which are signed by each account
+	 */
+	BodyBytes []byte
+
+	Signatures []*EnvelopeSignature
+}
+
+type EnvelopeSignature struct {
+	PublicKey []byte
+	Signature []byte
+}
+
+/**
+ * Set the sender without signing, so the body bytes can be created before the transaction is signed offline
+ */
+func (b *TransactionBase) SetSender(publicKey []byte) error {
+	if len(publicKey) != crypto.PublicKeyLength {
+		return errors.New("invalid public key")
+	}
+
+	if b.Network == 0 {
+		return errors.New("network unknown")
+	}
+
+	b.Sender = crypto.BuildRawAddress(publicKey, byte(b.Network))
+	b.SenderKeyType = KeyTypeED25519
+	b.SenderPublicKey = publicKey
+
+	return nil
+}
+
+/**
+ * Set the sponsor without signing, so the sponsor can co-sign an envelope of the transaction
+ */
+func (b *TransactionBase) SetSponsor(publicKey []byte) error {
+	if len(publicKey) != crypto.PublicKeyLength {
+		return errors.New("invalid public key")
+	}
+
+	if b.Version < 3 {
+		return errors.Errorf("sponsoring requires transaction version 3 or higher")
+	}
+
+	if b.Network == 0 {
+		return errors.New("network unknown")
+	}
+
+	b.Sponsor = crypto.BuildRawAddress(publicKey, byte(b.Network))
+	b.SponsorKeyType = KeyTypeED25519
+	b.SponsorPublicKey = publicKey
+
+	return nil
+}
+
+/**
+ * Export an unsigned transaction, the sender must already be set
+ */
+func NewEnvelope(tx Transaction) (*Envelope, error) {
+	base := tx.GetBase()
+
+	if len(base.Proofs) != 0 {
+		return nil, errors.New("transaction is already signed")
+	}
+
+	body, err := tx.GetBodyBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	base.ID = getTransactionID(body)
+
+	return &Envelope{
+		Transaction: tx,
+		BodyBytes:   body,
+	}, nil
+}
+
+/**
+ * Check that the body bytes match the transaction and that all signatures are valid
+ */
+func (e *Envelope) Verify() error {
+	if e.Transaction == nil {
+		return errors.New("envelope has no transaction")
+	}
+
+	body, err := e.Transaction.GetBodyBytes()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(body, e.BodyBytes) {
+		return errors.New("body bytes do not match the transaction")
+	}
+
+	for i, signature := range e.Signatures {
+		valid, err := crypto.VerifySignature(e.BodyBytes, signature.Signature, signature.PublicKey)
+		if err != nil {
+			return errors.Wrapf(err, "failed to verify signature %d", i)
+		}
+
+		if !valid {
+			return errors.Errorf("signature %d of %s is invalid", i, crypto.Base58Encode(signature.PublicKey))
+		}
+	}
+
+	return nil
+}
+
+func (e *Envelope) isSignedBy(publicKey []byte) bool {
+	return e.signatureOf(publicKey) != nil
+}
+
+func (e *Envelope) signatureOf(publicKey []byte) *EnvelopeSignature {
+	for _, signature := range e.Signatures {
+		if bytes.Equal(signature.PublicKey, publicKey) {
+			return signature
+		}
+	}
+
+	return nil
+}
+
+/**
+ * Add a signature of the account to the envelope
+ */
+func (a *Account) SignEnvelope(e *Envelope) error {
+	err := e.Verify()
+	if err != nil {
+		return err
+	}
+
+	if e.isSignedBy(a.Sign.PublicKey) {
+		return errors.New("envelope is already signed by the account")
+	}
+
+	signature, err := a.SignMessage(e.BodyBytes)
+	if err != nil {
+		return err
+	}
+
+	e.Signatures = append(e.Signatures, &EnvelopeSignature{
+		PublicKey: a.Sign.PublicKey,
+		Signature: signature,
+	})
+
+	return nil
+}
+
+/**
+ * Combine the signatures of envelopes of the same transaction into a transaction that can be broadcast.
+ * The signature of the sender is the first proof, followed by the one of the sponsor and those of other signers,
+ * e.g. of a multisig smart account.
+ */
+func MergeEnvelopes(envelopes ...*Envelope) (Transaction, error) {
+	if len(envelopes) == 0 {
+		return nil, errors.New("no envelopes to merge")
+	}
+
+	merged := new(Envelope)
+
+	for i, envelope := range envelopes {
+		err := envelope.Verify()
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid envelope %d", i)
+		}
+
+		if i > 0 && !bytes.Equal(envelope.BodyBytes, envelopes[0].BodyBytes) {
+			return nil, errors.Errorf("envelope %d is for a different transaction", i)
+		}
+
+		for _, signature := range envelope.Signatures {
+			if !merged.isSignedBy(signature.PublicKey) {
+				merged.Signatures = append(merged.Signatures, signature)
+			}
+		}
+	}
+
+	tx := envelopes[0].Transaction
+	base := tx.GetBase()
+
+	signatures := []*EnvelopeSignature{merged.signatureOf(base.SenderPublicKey)}
+	if signatures[0] == nil {
+		return nil, errors.New("envelopes are not signed by the sender")
+	}
+
+	if len(base.SponsorPublicKey) != 0 {
+		sponsor := merged.signatureOf(base.SponsorPublicKey)
+		if sponsor == nil {
+			return nil, errors.New("envelopes are not signed by the sponsor")
+		}
+
+		signatures = append(signatures, sponsor)
+	}
+
+	for _, signature := range merged.Signatures {
+		if !bytes.Equal(signature.PublicKey, base.SenderPublicKey) && !bytes.Equal(signature.PublicKey, base.SponsorPublicKey) {
+			signatures = append(signatures, signature)
+		}
+	}
+
+	base.Proofs = nil
+
+	for _, signature := range signatures {
+		err := base.Proofs.Add(signature.Signature)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	base.ID = getTransactionID(envelopes[0].BodyBytes)
+
+	return tx, nil
+}
+
+type envelopeSignatureJSON struct {
+	PublicKey string `json:"publicKey"`
+	Signature string `json:"signature"`
+}
+
+type envelopeJSON struct {
+	Transaction json.RawMessage          `json:"transaction"`
+	BodyBytes   string                   `json:"bodyBytes"`
+	Signatures  []*envelopeSignatureJSON `json:"signatures"`
+}
+
+func (e *Envelope) MarshalJSON() ([]byte, error) {
+	tx, err := json.Marshal(e.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := make([]*envelopeSignatureJSON, len(e.Signatures))
+	for i, signature := range e.Signatures {
+		signatures[i] = &envelopeSignatureJSON{
+			PublicKey: crypto.Base58Encode(signature.PublicKey),
+			Signature: crypto.Base58Encode(signature.Signature),
+		}
+	}
+
+	return json.Marshal(&envelopeJSON{
+		Transaction: tx,
+		BodyBytes:   crypto.Base58Encode(e.BodyBytes),
+		Signatures:  signatures,
+	})
+}
+
+/**
+ * Decode an envelope and verify it
+ */
+func (e *Envelope) UnmarshalJSON(data []byte) error {
+	res := new(envelopeJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	e.Transaction, err = DecodeTransaction(res.Transaction)
+	if err != nil {
+		return err
+	}
+
+	e.BodyBytes, err = decodeBase58("body bytes", res.BodyBytes)
+	if err != nil {
+		return err
+	}
+
+	e.Signatures = make([]*EnvelopeSignature, len(res.Signatures))
+	for i, signature := range res.Signatures {
+		publicKey, err := decodeBase58("public key", signature.PublicKey)
+		if err != nil {
+			return err
+		}
+
+		sig, err := decodeBase58("signature", signature.Signature)
+		if err != nil {
+			return err
+		}
+
+		e.Signatures[i] = &EnvelopeSignature{
+			PublicKey: publicKey,
+			Signature: sig,
+		}
+	}
+
+	return e.Verify()
+}
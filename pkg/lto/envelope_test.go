@@ -0,0 +1,191 @@
+package lto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestEnvelope(t *testing.T) {
+	alice, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	bob, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	tx, err := lto.NewTransfer().
+		WithNetwork(lto.NetworkTest).
+		WithRecipient(bob.Address).
+		WithAmount(100).
+		Create()
+	require.NoError(t, err)
+
+	err = tx.SetSender(alice.Sign.PublicKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte(alice.Address), tx.Sender)
+
+	err = tx.SetSponsor(bob.Sign.PublicKey)
+	require.NoError(t, err)
+
+	envelope, err := lto.NewEnvelope(tx)
+	require.NoError(t, err)
+
+	exported, err := json.Marshal(envelope)
+	require.NoError(t, err)
+
+	// sign copies of the envelope on separate machines, the sponsor first
+	signed := make([]*lto.Envelope, 2)
+	for i, account := range []*lto.Account{bob, alice} {
+		signed[i] = new(lto.Envelope)
+		err = json.Unmarshal(exported, signed[i])
+		require.NoError(t, err)
+
+		err = account.SignEnvelope(signed[i])
+		require.NoError(t, err)
+
+		err = account.SignEnvelope(signed[i])
+		require.Error(t, err)
+
+		data, err := json.Marshal(signed[i])
+		require.NoError(t, err)
+
+		signed[i] = new(lto.Envelope)
+		err = json.Unmarshal(data, signed[i])
+		require.NoError(t, err)
+	}
+
+	merged, err := lto.MergeEnvelopes(signed...)
+	require.NoError(t, err)
+
+	base := merged.GetBase()
+	require.Len(t, base.Proofs, 2)
+	require.Equal(t, tx.ID, base.ID)
+
+	body, err := merged.GetBodyBytes()
+	require.NoError(t, err)
+
+	valid, err := alice.Verify(base.Proofs[0], body)
+	require.NoError(t, err)
+	require.True(t, valid, "the sender signs the first proof")
+
+	err = lto.VerifyProofs(merged)
+	require.NoError(t, err)
+
+	_, err = lto.MergeEnvelopes(signed[0])
+	require.EqualError(t, err, "envelopes are not signed by the sender")
+
+	_, err = lto.MergeEnvelopes(signed[1])
+	require.EqualError(t, err, "envelopes are not signed by the sponsor")
+
+	charlie, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	require.NoError(t, charlie.SignEnvelope(signed[1]))
+
+	merged, err = lto.MergeEnvelopes(signed...)
+	require.NoError(t, err)
+
+	base = merged.GetBase()
+	require.Len(t, base.Proofs, 3)
+
+	valid, err = charlie.Verify(base.Proofs[2], body)
+	require.NoError(t, err)
+	require.True(t, valid, "other signers follow the sender and sponsor")
+
+	err = lto.VerifyProofs(merged)
+	require.NoError(t, err)
+
+	err = base.Proofs.Verify(body, alice.Sign.PublicKey, bob.Sign.PublicKey, charlie.Sign.PublicKey)
+	require.NoError(t, err)
+}
+
+func TestNewEnvelope(t *testing.T) {
+	a, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	tx, err := lto.NewTransfer().WithRecipient(a.Address).WithAmount(100).Create()
+	require.NoError(t, err)
+
+	_, err = lto.NewEnvelope(tx)
+	require.Error(t, err, "sender is not set")
+
+	err = tx.SetSender(a.Sign.PublicKey)
+	require.Error(t, err, "network is not set")
+
+	tx, err = tx.SignWith(a)
+	require.NoError(t, err)
+
+	_, err = lto.NewEnvelope(tx)
+	require.Error(t, err, "transaction is already signed")
+}
+
+func TestEnvelope_Verify(t *testing.T) {
+	alice, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	bob, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	newEnvelope := func() *lto.Envelope {
+		tx, err := lto.NewTransfer().
+			WithNetwork(lto.NetworkTest).
+			WithRecipient(bob.Address).
+			WithAmount(100).
+			WithTimestamp(1519862400000).
+			Create()
+		require.NoError(t, err)
+		require.NoError(t, tx.SetSender(alice.Sign.PublicKey))
+
+		envelope, err := lto.NewEnvelope(tx)
+		require.NoError(t, err)
+		require.NoError(t, alice.SignEnvelope(envelope))
+
+		return envelope
+	}
+
+	t.Run("should throw an error if the transaction was altered", func(t *testing.T) {
+		envelope := newEnvelope()
+		envelope.Transaction.(*lto.Transfer).Amount = 1000
+
+		require.Error(t, envelope.Verify())
+		require.Error(t, bob.SignEnvelope(envelope))
+
+		_, err := lto.MergeEnvelopes(envelope)
+		require.Error(t, err)
+	})
+
+	t.Run("should throw an error for an invalid signature", func(t *testing.T) {
+		envelope := newEnvelope()
+		envelope.Signatures[0].PublicKey = bob.Sign.PublicKey
+
+		require.Error(t, envelope.Verify())
+
+		data, err := json.Marshal(envelope)
+		require.NoError(t, err)
+		require.Error(t, json.Unmarshal(data, new(lto.Envelope)))
+	})
+
+	t.Run("should throw an error when merging envelopes of different transactions", func(t *testing.T) {
+		envelope := newEnvelope()
+		other := newEnvelope()
+		other.Transaction.(*lto.Transfer).Amount = 1000
+		other.BodyBytes, err = other.Transaction.GetBodyBytes()
+		require.NoError(t, err)
+		other.Signatures = nil
+		require.NoError(t, bob.SignEnvelope(other))
+
+		_, err := lto.MergeEnvelopes(envelope, other)
+		require.Error(t, err)
+	})
+
+	t.Run("should throw an error when merging unsigned envelopes", func(t *testing.T) {
+		envelope := newEnvelope()
+		envelope.Signatures = nil
+
+		_, err := lto.MergeEnvelopes(envelope)
+		require.Error(t, err)
+	})
+}
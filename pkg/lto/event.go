@@ -142,7 +142,12 @@ func (e *Event) VerifySignature() (bool, error) {
 }
 
 func (e *Event) GetBody(obj interface{}) error {
-	err := json.Unmarshal(crypto.Base58Decode(e.Body), &obj)
+	body, err := decodeBase58("body", e.Body)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(body, &obj)
 	if err != nil {
 		return err
 	}
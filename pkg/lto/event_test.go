@@ -250,6 +250,16 @@ func TestEvent_GetBody(t *testing.T) {
 	}
 }
 
+func TestEvent_GetBodyInvalid(t *testing.T) {
+	event, err := lto.NewEvent().WithBody(&Data{Foo: "bar"}).Create()
+	require.NoError(t, err)
+
+	event.Body = "0OIl"
+
+	err = event.GetBody(new(Data))
+	require.EqualError(t, err, "invalid body: invalid base58 character '0' at position 0")
+}
+
 func TestEvent_VerifySignature(t *testing.T) {
 	type fields struct {
 		body         interface{}
@@ -0,0 +1,130 @@
+package lto
+
+import (
+	"github.com/pkg/errors"
+)
+
+/**
+ * Default base fee of each transaction type
+ */
+var DefaultBaseFees = map[TransactionType]int64{
+	TransactionTypeGenesis:           0,
+	TransactionTypeTransfer:          TransferFee,
+	TransactionTypeLease:             LeaseFee,
+	TransactionTypeCancelLease:       CancelLeaseFee,
+	TransactionTypeMassTransfer:      MassTransferBaseFee,
+	TransactionTypeData:              DataBaseFee,
+	TransactionTypeSetScript:         SetScriptFee,
+	TransactionTypeAnchor:            AnchorBaseFee,
+	TransactionTypeAssociation:       AssociationFee,
+	TransactionTypeRevokeAssociation: RevokeAssociationFee,
+	TransactionTypeSponsorship:       SponsorshipFee,
+	TransactionTypeCancelSponsorship: CancelSponsorshipFee,
+}
+
+/**
+ * Default fee per item of transaction types with a variable fee.
+ * An item is a transfer, an anchor or a started block of 256 bytes of data.
+ */
+var DefaultVarFees = map[TransactionType]int64{
+	TransactionTypeMassTransfer: MassTransferVarFee,
+	TransactionTypeData:         DataVarFee,
+	TransactionTypeAnchor:       AnchorVarFee,
+}
+
+type FeeCalculator struct {
+	BaseFees map[TransactionType]int64
+	VarFees  map[TransactionType]int64
+}
+
+/**
+ * Create a fee calculator using the default fees, with the overrides of the config applied
+ */
+func NewFeeCalculator(config *BasicConfig) *FeeCalculator {
+	c := &FeeCalculator{
+		BaseFees: make(map[TransactionType]int64, len(DefaultBaseFees)),
+		VarFees:  make(map[TransactionType]int64, len(DefaultVarFees)),
+	}
+
+	for txType, fee := range DefaultBaseFees {
+		c.BaseFees[txType] = fee
+	}
+
+	for txType, fee := range DefaultVarFees {
+		c.VarFees[txType] = fee
+	}
+
+	if config == nil {
+		return c
+	}
+
+	for txType, fee := range config.BaseFees {
+		c.BaseFees[txType] = fee
+	}
+
+	for txType, fee := range config.VarFees {
+		c.VarFees[txType] = fee
+	}
+
+	return c
+}
+
+/**
+ * Calculate the minimum fee of the transaction
+ */
+func (c *FeeCalculator) Calculate(tx Transaction) (int64, error) {
+	txType := tx.GetBase().Type
+
+	baseFee, ok := c.BaseFees[txType]
+	if !ok {
+		return 0, errors.Errorf("no fee known for transaction type %d", txType)
+	}
+
+	items, err := getFeeItems(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	return baseFee + items*c.VarFees[txType], nil
+}
+
+func getFeeItems(tx Transaction) (int64, error) {
+	switch tx := tx.(type) {
+	case *MassTransfer:
+		return int64(len(tx.Transfers)), nil
+	case *Anchor:
+		return int64(len(tx.Anchors)), nil
+	case *Data:
+		dataBytes, err := tx.getEntriesBytes()
+		if err != nil {
+			return 0, err
+		}
+
+		return int64((len(dataBytes) + 255) / 256), nil
+	default:
+		return 0, nil
+	}
+}
+
+/**
+ * Set the fee of the transaction if it is not set yet
+ */
+func fillFee(tx Transaction, fees *FeeCalculator) error {
+	base := tx.GetBase()
+	if base.Fee != 0 {
+		return nil
+	}
+
+	if fees == nil {
+		fees = NewFeeCalculator(nil)
+	}
+
+	fee, err := fees.Calculate(tx)
+	if err != nil {
+		return err
+	}
+
+	base.Fee = fee
+
+	return nil
+}
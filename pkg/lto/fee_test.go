@@ -0,0 +1,161 @@
+package lto_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestFeeCalculator_Calculate(t *testing.T) {
+	recipient := crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")
+	hash := crypto.Blake2b([]byte("hello"))
+
+	newTx := func(tx lto.Transaction, err error) lto.Transaction {
+		require.NoError(t, err)
+		return tx
+	}
+
+	tests := []struct {
+		name   string
+		config *lto.BasicConfig
+		tx     lto.Transaction
+		want   int64
+	}{
+		{
+			name: "should calculate the fee of a transfer",
+			tx:   newTx(lto.NewTransfer().WithRecipient(recipient).WithAmount(1).Create()),
+			want: 100000000,
+		},
+		{
+			name: "should calculate the fee of an anchor per anchor",
+			tx:   newTx(lto.NewAnchor().WithAnchors(hash, hash, hash).Create()),
+			want: 55000000,
+		},
+		{
+			name: "should calculate the fee of a mass transfer per transfer",
+			tx:   newTx(lto.NewMassTransfer().WithTransfer(recipient, 1).WithTransfer(recipient, 2).Create()),
+			want: 120000000,
+		},
+		{
+			name: "should calculate the fee of data per 256 bytes",
+			tx:   newTx(lto.NewData().WithBinary("a", make([]byte, 300)).Create()),
+			want: 120000000,
+		},
+		{
+			name: "should calculate the fee of a sponsorship",
+			tx:   newTx(lto.NewSponsorship().WithRecipient(recipient).Create()),
+			want: 500000000,
+		},
+		{
+			name: "should apply an override of the base fee",
+			config: &lto.BasicConfig{
+				BaseFees: map[lto.TransactionType]int64{lto.TransactionTypeAnchor: 10000000},
+			},
+			tx:   newTx(lto.NewAnchor().WithAnchors(hash).Create()),
+			want: 20000000,
+		},
+		{
+			name: "should apply an override of the fee per item",
+			config: &lto.BasicConfig{
+				VarFees: map[lto.TransactionType]int64{lto.TransactionTypeMassTransfer: 5000000},
+			},
+			tx:   newTx(lto.NewMassTransfer().WithTransfer(recipient, 1).WithTransfer(recipient, 2).Create()),
+			want: 110000000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lto.NewFeeCalculator(tt.config).Calculate(tt.tx)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFeeCalculator_Defaults(t *testing.T) {
+	config := lto.DefaultBasicConfig()
+	config.BaseFees = map[lto.TransactionType]int64{lto.TransactionTypeTransfer: 1}
+
+	lto.NewFeeCalculator(config)
+	require.Equal(t, lto.TransferFee, lto.DefaultBaseFees[lto.TransactionTypeTransfer])
+}
+
+func TestClient_NewTransfer(t *testing.T) {
+	config := lto.DefaultTestNetConfig()
+	config.BaseFees = map[lto.TransactionType]int64{lto.TransactionTypeTransfer: 50000000}
+
+	client, err := lto.NewClient().WithNetworkConfig(config).Create()
+	require.NoError(t, err)
+
+	tx, err := client.NewTransfer().
+		WithRecipient(crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")).
+		WithAmount(1).
+		Create()
+	require.NoError(t, err)
+	require.Equal(t, int64(50000000), tx.Fee)
+	require.Equal(t, lto.NetworkTest, tx.Network)
+
+	tx, err = client.NewTransfer().
+		WithRecipient(crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")).
+		WithAmount(1).
+		WithFee(200000000).
+		Create()
+	require.NoError(t, err)
+	require.Equal(t, int64(200000000), tx.Fee)
+}
+
+func TestClient_EstimateFee(t *testing.T) {
+	tests := []struct {
+		name    string
+		nodeFee string
+		want    int64
+		wantErr bool
+	}{
+		{
+			name:    "should return the fee if it matches the node",
+			nodeFee: `{"feeAssetId": null, "feeAmount": 35000000}`,
+			want:    35000000,
+		},
+		{
+			name:    "should throw an error if the fee doesn't match the node",
+			nodeFee: `{"feeAssetId": null, "feeAmount": 45000000}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/transactions/calculateFee", r.URL.Path)
+				require.Equal(t, http.MethodPost, r.Method)
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.nodeFee))
+			}))
+			defer server.Close()
+
+			client, err := lto.NewClient().WithNetwork(lto.NetworkTest).WithNodeAddress(server.URL).Create()
+			require.NoError(t, err)
+
+			a, err := client.NewAccount().Create()
+			require.NoError(t, err)
+
+			tx, err := client.NewAnchor().WithAnchors(crypto.Blake2b([]byte("hello"))).Create()
+			require.NoError(t, err)
+			require.NoError(t, tx.SetSender(a.Sign.PublicKey))
+
+			got, err := client.EstimateFee(tx)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package lto
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const DefaultFollowerPollInterval = 5 * time.Second
+const DefaultFollowerWindow = 100
+const DefaultFollowerMaxFailures = 5
+
+/**
+ * Number of blocks fetched per request while catching up
+ */
+const MaxBlocksPerRequest = 100
+
+/**
+ * Returned when the chain forked before the oldest block in the window of the follower
+ */
+var ErrReorgTooDeep = errors.New("reorg goes deeper than the window of recent blocks")
+
+type BlockEventType int
+
+const (
+	/**
+	 * The block was added to the chain
+	 */
+	BlockEventNew BlockEventType = iota
+
+	/**
+	 * The block was orphaned by a reorg, rollbacks are emitted from the newest block down
+	 */
+	BlockEventRollback
+
+	/**
+	 * Polling the node failed, it's retried on the next poll
+	 */
+	BlockEventError
+)
+
+type BlockEvent struct {
+	Type  BlockEventType
+	Block *BlocksGetResponse
+
+	/**
+	 * Reason polling failed, only set for BlockEventError
+	 */
+	Err error
+}
+
+type blockFollowerParams struct {
+	api          *API
+	startHeight  int64
+	pollInterval time.Duration
+	window       int
+	maxFailures  int
+}
+
+func NewBlockFollower(api *API) *blockFollowerParams {
+	return &blockFollowerParams{
+		api:          api,
+		pollInterval: DefaultFollowerPollInterval,
+		window:       DefaultFollowerWindow,
+		maxFailures:  DefaultFollowerMaxFailures,
+	}
+}
+
+func (p *blockFollowerParams) Create() (*BlockFollower, error) {
+	if p.api == nil {
+		return nil, errors.New("no api set")
+	}
+
+	if p.startHeight < 0 {
+		return nil, errors.New("invalid start height")
+	}
+
+	if p.pollInterval <= 0 {
+		return nil, errors.New("invalid poll interval")
+	}
+
+	if p.window < 1 {
+		return nil, errors.New("window must be at least 1 block")
+	}
+
+	if p.maxFailures < 0 {
+		return nil, errors.New("invalid max failures")
+	}
+
+	return &BlockFollower{
+		api:          p.api,
+		startHeight:  p.startHeight,
+		pollInterval: p.pollInterval,
+		window:       p.window,
+		maxFailures:  p.maxFailures,
+	}, nil
+}
+
+/**
+ * Height of the first block to emit, by default only blocks after the current height are emitted
+ */
+func (p *blockFollowerParams) WithStartHeight(height int64) *blockFollowerParams {
+	p.startHeight = height
+	return p
+}
+
+func (p *blockFollowerParams) WithPollInterval(interval time.Duration) *blockFollowerParams {
+	p.pollInterval = interval
+	return p
+}
+
+/**
+ * Number of recent blocks kept to detect reorgs, a reorg deeper than the window stops the follower
+ */
+func (p *blockFollowerParams) WithWindow(blocks int) *blockFollowerParams {
+	p.window = blocks
+	return p
+}
+
+/**
+ * Number of consecutive failed polls after which the follower stops, 0 to keep retrying
+ */
+func (p *blockFollowerParams) WithMaxFailures(failures int) *blockFollowerParams {
+	p.maxFailures = failures
+	return p
+}
+
+type BlockFollower struct {
+	api          *API
+	startHeight  int64
+	pollInterval time.Duration
+	window       int
+	maxFailures  int
+
+	blocks  []*BlocksGetResponse
+	trimmed bool
+	next    int64
+	err     error
+}
+
+/**
+ * Emit each new block in order on the channel until the context is done.
+ * The channel is closed when the follower stops, Err returns the reason.
+ * A failed poll is emitted as a BlockEventError and retried, until it fails too many times in a row.
+ */
+func (f *BlockFollower) Follow(ctx context.Context) <-chan *BlockEvent {
+	events := make(chan *BlockEvent)
+
+	go func() {
+		defer close(events)
+		f.err = f.run(ctx, events)
+	}()
+
+	return events
+}
+
+/**
+ * The reason the follower stopped, only valid after the channel is closed
+ */
+func (f *BlockFollower) Err() error {
+	return f.err
+}
+
+func (f *BlockFollower) run(ctx context.Context, events chan<- *BlockEvent) error {
+	f.next = f.startHeight
+	failures := 0
+
+	for {
+		err := f.poll(ctx, events)
+		if err == ErrReorgTooDeep {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return errors.Wrap(ctx.Err(), "stopped following blocks")
+		}
+
+		if err == nil {
+			failures = 0
+		} else {
+			failures++
+
+			emitErr := f.emit(ctx, events, &BlockEvent{Type: BlockEventError, Err: err})
+			if emitErr != nil {
+				return errors.Wrap(emitErr, "stopped following blocks")
+			}
+
+			if f.maxFailures != 0 && failures >= f.maxFailures {
+				return errors.Wrapf(err, "stopped following blocks after %d failed polls", failures)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "stopped following blocks")
+		case <-time.After(f.pollInterval):
+		}
+	}
+}
+
+func (f *BlockFollower) poll(ctx context.Context, events chan<- *BlockEvent) error {
+	height, err := f.api.BlocksHeightContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	tip := height.Height
+	if f.next == 0 {
+		f.next = tip + 1
+	}
+
+	for {
+		last := f.last()
+		if last != nil && last.Height > tip {
+			err = f.rollback(ctx, events)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if f.next > tip {
+			return nil
+		}
+
+		to := f.next + MaxBlocksPerRequest - 1
+		if to > tip {
+			to = tip
+		}
+
+		blocks, err := f.api.BlocksSeqContext(ctx, f.next, to)
+		if err != nil {
+			return err
+		}
+
+		if len(blocks) == 0 {
+			return nil
+		}
+
+		for _, block := range blocks {
+			last := f.last()
+			if last != nil && block.Reference != last.Signature {
+				err = f.rollback(ctx, events)
+				if err != nil {
+					return err
+				}
+				break
+			}
+
+			err = f.emit(ctx, events, &BlockEvent{Type: BlockEventNew, Block: block})
+			if err != nil {
+				return err
+			}
+
+			f.blocks = append(f.blocks, block)
+			if len(f.blocks) > f.window {
+				f.blocks = f.blocks[1:]
+				f.trimmed = true
+			}
+			f.next = block.Height + 1
+		}
+	}
+}
+
+func (f *BlockFollower) last() *BlocksGetResponse {
+	if len(f.blocks) == 0 {
+		return nil
+	}
+
+	return f.blocks[len(f.blocks)-1]
+}
+
+/**
+ * Drop the newest block of the window, as it is no longer part of the chain
+ */
+func (f *BlockFollower) rollback(ctx context.Context, events chan<- *BlockEvent) error {
+	last := f.last()
+
+	err := f.emit(ctx, events, &BlockEvent{Type: BlockEventRollback, Block: last})
+	if err != nil {
+		return err
+	}
+
+	f.blocks = f.blocks[:len(f.blocks)-1]
+	f.next = last.Height
+
+	if len(f.blocks) == 0 && f.trimmed {
+		return ErrReorgTooDeep
+	}
+
+	return nil
+}
+
+func (f *BlockFollower) emit(ctx context.Context, events chan<- *BlockEvent, event *BlockEvent) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case events <- event:
+		return nil
+	}
+}
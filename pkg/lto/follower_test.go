@@ -0,0 +1,244 @@
+package lto_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+/**
+ * Chain of blocks, the signature of a block is its branch followed by its height
+ */
+type fakeBlocks struct {
+	sync.Mutex
+	signatures []string
+
+	/**
+	 * Height of a block served with a transaction that can't be decoded
+	 */
+	broken int
+}
+
+func (c *fakeBlocks) set(branches ...string) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.signatures = nil
+	for i, branch := range branches {
+		c.signatures = append(c.signatures, fmt.Sprintf("%s%d", branch, i+1))
+	}
+}
+
+func (c *fakeBlocks) breakBlock(height int) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.broken = height
+}
+
+func (c *fakeBlocks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.Lock()
+	defer c.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.URL.Path == "/blocks/height" {
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"height": %d}`, len(c.signatures))))
+		return
+	}
+
+	var from, to int
+	_, err := fmt.Sscanf(r.URL.Path, "/blocks/seq/%d/%d", &from, &to)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	var blocks []string
+	for height := from; height <= to && height <= len(c.signatures); height++ {
+		reference := ""
+		if height > 1 {
+			reference = c.signatures[height-2]
+		}
+
+		transactions := "[]"
+		if height == c.broken {
+			transactions = `[{"type": 4, "version": 3, "sender": "0OIl", "senderPublicKey": ""}]`
+		}
+
+		blocks = append(blocks, fmt.Sprintf(
+			`{"signature": "%s", "reference": "%s", "height": %d, "transactions": %s}`,
+			c.signatures[height-1], reference, height, transactions,
+		))
+	}
+
+	_, _ = w.Write([]byte("[" + strings.Join(blocks, ",") + "]"))
+}
+
+func describeEvent(event *lto.BlockEvent) string {
+	switch event.Type {
+	case lto.BlockEventRollback:
+		return "-" + event.Block.Signature
+	case lto.BlockEventError:
+		return "error"
+	default:
+		return "+" + event.Block.Signature
+	}
+}
+
+func receiveEvents(t *testing.T, events <-chan *lto.BlockEvent, n int) []string {
+	var got []string
+
+	for i := 0; i < n; i++ {
+		select {
+		case event, ok := <-events:
+			require.True(t, ok, "channel closed after %v", got)
+			got = append(got, describeEvent(event))
+		case <-time.After(time.Second):
+			require.FailNow(t, "timeout waiting for block events", "got %v", got)
+		}
+	}
+
+	return got
+}
+
+func TestBlockFollower_Follow(t *testing.T) {
+	chain := new(fakeBlocks)
+	chain.set("a", "a", "a")
+
+	server := httptest.NewServer(chain)
+	defer server.Close()
+
+	config := lto.DefaultTestNetConfig()
+	config.NodeAddress = server.URL
+
+	api, err := lto.NewAPI(config)
+	require.NoError(t, err)
+
+	t.Run("should emit new blocks and roll back orphaned blocks", func(t *testing.T) {
+		chain.set("a", "a", "a")
+
+		follower, err := lto.NewBlockFollower(api).
+			WithStartHeight(1).
+			WithPollInterval(time.Millisecond).
+			Create()
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events := follower.Follow(ctx)
+		require.Equal(t, []string{"+a1", "+a2", "+a3"}, receiveEvents(t, events, 3))
+
+		chain.set("a", "b", "b", "b")
+		require.Equal(t, []string{"-a3", "-a2", "+b2", "+b3", "+b4"}, receiveEvents(t, events, 5))
+
+		chain.set("a", "b", "b")
+		require.Equal(t, []string{"-b4"}, receiveEvents(t, events, 1))
+
+		chain.set("a", "b", "b", "c")
+		require.Equal(t, []string{"+c4"}, receiveEvents(t, events, 1))
+
+		cancel()
+		for range events {
+		}
+		require.Equal(t, context.Canceled, errors.Cause(follower.Err()))
+	})
+
+	t.Run("should only emit blocks after the current height by default", func(t *testing.T) {
+		chain.set("a", "a", "a")
+
+		follower, err := lto.NewBlockFollower(api).
+			WithPollInterval(time.Millisecond).
+			Create()
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events := follower.Follow(ctx)
+
+		time.Sleep(20 * time.Millisecond)
+		chain.set("a", "a", "a", "a")
+		require.Equal(t, []string{"+a4"}, receiveEvents(t, events, 1))
+	})
+
+	t.Run("should stop when the reorg is deeper than the window", func(t *testing.T) {
+		chain.set("a", "a", "a", "a")
+
+		follower, err := lto.NewBlockFollower(api).
+			WithStartHeight(1).
+			WithPollInterval(time.Millisecond).
+			WithWindow(2).
+			Create()
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events := follower.Follow(ctx)
+		require.Equal(t, []string{"+a1", "+a2", "+a3", "+a4"}, receiveEvents(t, events, 4))
+
+		chain.set("c", "c", "c", "c", "c")
+		require.Equal(t, []string{"-a4", "-a3"}, receiveEvents(t, events, 2))
+
+		for range events {
+		}
+		require.Equal(t, lto.ErrReorgTooDeep, follower.Err())
+	})
+
+	t.Run("should emit errors and stop after too many failed polls", func(t *testing.T) {
+		chain.set("a", "a", "a")
+		chain.breakBlock(2)
+		defer chain.breakBlock(0)
+
+		follower, err := lto.NewBlockFollower(api).
+			WithStartHeight(1).
+			WithPollInterval(time.Millisecond).
+			WithMaxFailures(3).
+			Create()
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events := follower.Follow(ctx)
+		require.Equal(t, []string{"error", "error", "error"}, receiveEvents(t, events, 3))
+
+		_, ok := <-events
+		require.False(t, ok)
+		require.EqualError(t, follower.Err(), "stopped following blocks after 3 failed polls: failed to get block: "+
+			"failed to decode transaction: invalid sender: invalid base58 character '0' at position 0")
+	})
+}
+
+func TestBlockFollower_Create(t *testing.T) {
+	api, err := lto.NewAPI(lto.DefaultTestNetConfig())
+	require.NoError(t, err)
+
+	_, err = lto.NewBlockFollower(nil).Create()
+	require.Error(t, err)
+
+	_, err = lto.NewBlockFollower(api).WithWindow(0).Create()
+	require.Error(t, err)
+
+	_, err = lto.NewBlockFollower(api).WithPollInterval(0).Create()
+	require.Error(t, err)
+
+	_, err = lto.NewBlockFollower(api).WithStartHeight(-1).Create()
+	require.Error(t, err)
+
+	_, err = lto.NewBlockFollower(api).WithMaxFailures(-1).Create()
+	require.Error(t, err)
+}
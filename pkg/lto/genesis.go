@@ -0,0 +1,96 @@
+package lto
+
+import (
+	"encoding/json"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+/**
+ * Transaction in the first block distributing the initial supply, it can only be decoded
+ */
+type Genesis struct {
+	TransactionBase
+
+	Recipient []byte
+	Amount    int64
+}
+
+func (t *Genesis) GetBodyBytes() ([]byte, error) {
+	if t.Version != 1 {
+		return nil, errors.Errorf("unsupported genesis version %d", t.Version)
+	}
+
+	return writeBinary(
+		t.Type,
+		t.Timestamp,
+		t.Recipient,
+		t.Amount,
+	)
+}
+
+type genesisJSON struct {
+	ID        string          `json:"id,omitempty"`
+	Type      TransactionType `json:"type"`
+	Version   byte            `json:"version"`
+	Fee       int64           `json:"fee"`
+	Timestamp int64           `json:"timestamp"`
+	Signature string          `json:"signature"`
+	Recipient string          `json:"recipient"`
+	Amount    int64           `json:"amount"`
+	Height    int64           `json:"height,omitempty"`
+}
+
+func (t *Genesis) MarshalJSON() ([]byte, error) {
+	var signature []byte
+	if len(t.Proofs) != 0 {
+		signature = t.Proofs[0]
+	}
+
+	return json.Marshal(&genesisJSON{
+		ID:        t.ID,
+		Type:      t.Type,
+		Version:   t.Version,
+		Fee:       t.Fee,
+		Timestamp: t.Timestamp,
+		Signature: crypto.Base58Encode(signature),
+		Recipient: crypto.Base58Encode(t.Recipient),
+		Amount:    t.Amount,
+		Height:    t.Height,
+	})
+}
+
+func (t *Genesis) UnmarshalJSON(data []byte) error {
+	res := new(genesisJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	signature, err := decodeBase58("signature", res.Signature)
+	if err != nil {
+		return err
+	}
+
+	t.Recipient, err = decodeBase58("recipient", res.Recipient)
+	if err != nil {
+		return err
+	}
+
+	t.ID = res.ID
+	t.Type = res.Type
+	t.Version = res.Version
+	t.Fee = res.Fee
+	t.Timestamp = res.Timestamp
+	t.Proofs = Proofs{signature}
+	t.Amount = res.Amount
+	t.Height = res.Height
+
+	if len(t.Recipient) == crypto.AddressLength {
+		t.Network = Network(t.Recipient[1])
+	}
+
+	return nil
+}
@@ -0,0 +1,382 @@
+package lto
+
+import (
+	"encoding/json"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+const LeaseFee int64 = 100000000
+const LeaseDefaultVersion byte = 3
+
+type leaseParams struct {
+	version   byte
+	network   Network
+	recipient []byte
+	amount    int64
+	fee       int64
+	timestamp int64
+	fees      *FeeCalculator
+}
+
+func NewLease() *leaseParams {
+	return &leaseParams{
+		version:   LeaseDefaultVersion,
+		timestamp: getTimestamp(),
+	}
+}
+
+func (p *leaseParams) Create() (*Lease, error) {
+	if p.version != 2 && p.version != 3 {
+		return nil, errors.Errorf("unsupported lease version %d", p.version)
+	}
+
+	if len(p.recipient) != crypto.AddressLength {
+		return nil, errors.New("invalid recipient")
+	}
+
+	if p.network != 0 && !crypto.IsValidAddress(p.recipient, byte(p.network)) {
+		return nil, errors.New("recipient is not a valid address for the network")
+	}
+
+	if p.amount <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	tx := &Lease{
+		TransactionBase: TransactionBase{
+			Type:      TransactionTypeLease,
+			Version:   p.version,
+			Network:   p.network,
+			Fee:       p.fee,
+			Timestamp: p.timestamp,
+		},
+		Recipient: p.recipient,
+		Amount:    p.amount,
+	}
+
+	err := fillFee(tx, p.fees)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (p *leaseParams) WithRecipient(recipient []byte) *leaseParams {
+	p.recipient = recipient
+	return p
+}
+
+func (p *leaseParams) WithAmount(amount int64) *leaseParams {
+	p.amount = amount
+	return p
+}
+
+func (p *leaseParams) WithFee(fee int64) *leaseParams {
+	p.fee = fee
+	return p
+}
+
+func (p *leaseParams) WithTimestamp(timestamp int64) *leaseParams {
+	p.timestamp = timestamp
+	return p
+}
+
+func (p *leaseParams) WithVersion(version byte) *leaseParams {
+	p.version = version
+	return p
+}
+
+func (p *leaseParams) WithNetwork(network Network) *leaseParams {
+	p.network = network
+	return p
+}
+
+func (p *leaseParams) WithNetworkConfig(config *Config) *leaseParams {
+	p.network = config.Network
+	p.fees = NewFeeCalculator(config.BasicConfig)
+	return p
+}
+
+type Lease struct {
+	TransactionBase
+
+	/**
+	 * Address of the node the amount is leased to
+	 */
+	Recipient []byte
+
+	Amount int64
+}
+
+func (t *Lease) GetBodyBytes() ([]byte, error) {
+	if len(t.SenderPublicKey) == 0 {
+		return nil, errors.New("first set sender before creating body bytes")
+	}
+
+	switch t.Version {
+	case 2:
+		return writeBinary(
+			t.Type,
+			t.Version,
+			byte(0),
+			t.SenderPublicKey,
+			t.Recipient,
+			t.Amount,
+			t.Fee,
+			t.Timestamp,
+		)
+	case 3:
+		if t.Network == 0 {
+			return nil, errors.New("network unknown")
+		}
+
+		return writeBinary(
+			t.Type,
+			t.Version,
+			t.Network,
+			t.Timestamp,
+			t.SenderKeyType,
+			t.SenderPublicKey,
+			t.Fee,
+			t.Recipient,
+			t.Amount,
+		)
+	default:
+		return nil, errors.Errorf("unsupported lease version %d", t.Version)
+	}
+}
+
+func (t *Lease) SignWith(account *Account) (*Lease, error) {
+	err := account.SignTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *Lease) SponsorWith(account *Account) (*Lease, error) {
+	err := account.SponsorTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type leaseJSON struct {
+	transactionBaseJSON
+	Recipient string `json:"recipient"`
+	Amount    int64  `json:"amount"`
+}
+
+func (t *Lease) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&leaseJSON{
+		transactionBaseJSON: t.toJSON(),
+		Recipient:           crypto.Base58Encode(t.Recipient),
+		Amount:              t.Amount,
+	})
+}
+
+func (t *Lease) UnmarshalJSON(data []byte) error {
+	res := new(leaseJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	err = t.fromJSON(&res.transactionBaseJSON)
+	if err != nil {
+		return err
+	}
+
+	t.Recipient, err = decodeBase58("recipient", res.Recipient)
+	if err != nil {
+		return err
+	}
+
+	t.Amount = res.Amount
+
+	return nil
+}
+
+const CancelLeaseFee int64 = 100000000
+const CancelLeaseDefaultVersion byte = 3
+
+type cancelLeaseParams struct {
+	version   byte
+	network   Network
+	leaseID   string
+	fee       int64
+	timestamp int64
+	fees      *FeeCalculator
+}
+
+func NewCancelLease() *cancelLeaseParams {
+	return &cancelLeaseParams{
+		version:   CancelLeaseDefaultVersion,
+		timestamp: getTimestamp(),
+	}
+}
+
+func (p *cancelLeaseParams) Create() (*CancelLease, error) {
+	if p.version != 2 && p.version != 3 {
+		return nil, errors.Errorf("unsupported cancel lease version %d", p.version)
+	}
+
+	leaseID, err := crypto.Base58DecodeStrict(p.leaseID)
+	if err != nil || len(leaseID) != 32 {
+		return nil, errors.New("invalid lease id")
+	}
+
+	tx := &CancelLease{
+		TransactionBase: TransactionBase{
+			Type:      TransactionTypeCancelLease,
+			Version:   p.version,
+			Network:   p.network,
+			Fee:       p.fee,
+			Timestamp: p.timestamp,
+		},
+		LeaseID: p.leaseID,
+	}
+
+	err = fillFee(tx, p.fees)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (p *cancelLeaseParams) WithLeaseID(leaseID string) *cancelLeaseParams {
+	p.leaseID = leaseID
+	return p
+}
+
+func (p *cancelLeaseParams) WithFee(fee int64) *cancelLeaseParams {
+	p.fee = fee
+	return p
+}
+
+func (p *cancelLeaseParams) WithTimestamp(timestamp int64) *cancelLeaseParams {
+	p.timestamp = timestamp
+	return p
+}
+
+func (p *cancelLeaseParams) WithVersion(version byte) *cancelLeaseParams {
+	p.version = version
+	return p
+}
+
+func (p *cancelLeaseParams) WithNetwork(network Network) *cancelLeaseParams {
+	p.network = network
+	return p
+}
+
+func (p *cancelLeaseParams) WithNetworkConfig(config *Config) *cancelLeaseParams {
+	p.network = config.Network
+	p.fees = NewFeeCalculator(config.BasicConfig)
+	return p
+}
+
+type CancelLease struct {
+	TransactionBase
+
+	/**
+	 * ID of the lease transaction to cancel
+	 */
+	LeaseID string
+}
+
+func (t *CancelLease) GetBodyBytes() ([]byte, error) {
+	if len(t.SenderPublicKey) == 0 {
+		return nil, errors.New("first set sender before creating body bytes")
+	}
+
+	if t.Network == 0 {
+		return nil, errors.New("network unknown")
+	}
+
+	leaseID, err := decodeBase58("lease id", t.LeaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch t.Version {
+	case 2:
+		return writeBinary(
+			t.Type,
+			t.Version,
+			t.Network,
+			t.SenderPublicKey,
+			t.Fee,
+			t.Timestamp,
+			leaseID,
+		)
+	case 3:
+		return writeBinary(
+			t.Type,
+			t.Version,
+			t.Network,
+			t.Timestamp,
+			t.SenderKeyType,
+			t.SenderPublicKey,
+			t.Fee,
+			leaseID,
+		)
+	default:
+		return nil, errors.Errorf("unsupported cancel lease version %d", t.Version)
+	}
+}
+
+func (t *CancelLease) SignWith(account *Account) (*CancelLease, error) {
+	err := account.SignTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *CancelLease) SponsorWith(account *Account) (*CancelLease, error) {
+	err := account.SponsorTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type cancelLeaseJSON struct {
+	transactionBaseJSON
+	LeaseID string `json:"leaseId"`
+}
+
+func (t *CancelLease) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&cancelLeaseJSON{
+		transactionBaseJSON: t.toJSON(),
+		LeaseID:             t.LeaseID,
+	})
+}
+
+func (t *CancelLease) UnmarshalJSON(data []byte) error {
+	res := new(cancelLeaseJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	err = t.fromJSON(&res.transactionBaseJSON)
+	if err != nil {
+		return err
+	}
+
+	t.LeaseID = res.LeaseID
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package lto_test
+
+import (
+	"testing"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestLease_GetBodyBytes(t *testing.T) {
+	publicKey := crypto.Base58Decode("FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y")
+	recipient := crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")
+
+	tests := []struct {
+		name    string
+		version byte
+		want    []byte
+	}{
+		{
+			name:    "should serialize a v2 lease",
+			version: 2,
+			want: concat(
+				[]byte{8, 2, 0},
+				publicKey,
+				recipient,
+				[]byte{0, 0, 0, 0, 0x3b, 0x9a, 0xca, 0x00},
+				[]byte{0, 0, 0, 0, 0x05, 0xf5, 0xe1, 0x00},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+			),
+		},
+		{
+			name:    "should serialize a v3 lease",
+			version: 3,
+			want: concat(
+				[]byte{8, 3, 'T'},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{1},
+				publicKey,
+				[]byte{0, 0, 0, 0, 0x05, 0xf5, 0xe1, 0x00},
+				recipient,
+				[]byte{0, 0, 0, 0, 0x3b, 0x9a, 0xca, 0x00},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := lto.NewAccount().
+				FromPrivateKey(crypto.Base58Decode("wJ4WH8dD88fSkNdFQRjaAhjFUZzZhV5yiDLDwNUnp6bYwRXrvWV8MJhQ9HL9uqMDG1n7XpTGZx7PafqaayQV8Rp")).
+				WithNetwork(lto.NetworkTest).
+				Create()
+			require.NoError(t, err)
+
+			tx, err := lto.NewLease().
+				WithVersion(tt.version).
+				WithRecipient(recipient).
+				WithAmount(1000000000).
+				WithTimestamp(1519862400000).
+				Create()
+			require.NoError(t, err)
+
+			tx, err = tx.SignWith(a)
+			require.NoError(t, err)
+
+			body, err := tx.GetBodyBytes()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, body)
+
+			valid, err := a.Verify(tx.Proofs[0], body)
+			require.NoError(t, err)
+			require.True(t, valid)
+		})
+	}
+}
+
+func TestCancelLease_GetBodyBytes(t *testing.T) {
+	publicKey := crypto.Base58Decode("FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y")
+	leaseID := crypto.Sha256([]byte("lease"))
+
+	tests := []struct {
+		name    string
+		version byte
+		leaseID string
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "should serialize a v2 cancel lease",
+			version: 2,
+			leaseID: crypto.Base58Encode(leaseID),
+			want: concat(
+				[]byte{9, 2, 'T'},
+				publicKey,
+				[]byte{0, 0, 0, 0, 0x05, 0xf5, 0xe1, 0x00},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				leaseID,
+			),
+		},
+		{
+			name:    "should serialize a v3 cancel lease",
+			version: 3,
+			leaseID: crypto.Base58Encode(leaseID),
+			want: concat(
+				[]byte{9, 3, 'T'},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{1},
+				publicKey,
+				[]byte{0, 0, 0, 0, 0x05, 0xf5, 0xe1, 0x00},
+				leaseID,
+			),
+		},
+		{
+			name:    "should throw an error for an invalid lease id",
+			version: 3,
+			leaseID: "foo",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := lto.NewAccount().
+				FromPrivateKey(crypto.Base58Decode("wJ4WH8dD88fSkNdFQRjaAhjFUZzZhV5yiDLDwNUnp6bYwRXrvWV8MJhQ9HL9uqMDG1n7XpTGZx7PafqaayQV8Rp")).
+				WithNetwork(lto.NetworkTest).
+				Create()
+			require.NoError(t, err)
+
+			tx, err := lto.NewCancelLease().
+				WithVersion(tt.version).
+				WithLeaseID(tt.leaseID).
+				WithTimestamp(1519862400000).
+				Create()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			tx, err = tx.SignWith(a)
+			require.NoError(t, err)
+
+			body, err := tx.GetBodyBytes()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, body)
+		})
+	}
+}
@@ -0,0 +1,282 @@
+package lto
+
+import (
+	"encoding/json"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+const MassTransferBaseFee int64 = 100000000
+const MassTransferVarFee int64 = 10000000
+const MassTransferDefaultVersion byte = 3
+const MaxMassTransfers = 100
+
+type massTransferParams struct {
+	version    byte
+	network    Network
+	transfers  []*MassTransferItem
+	attachment []byte
+	fee        int64
+	timestamp  int64
+	fees       *FeeCalculator
+}
+
+func NewMassTransfer() *massTransferParams {
+	return &massTransferParams{
+		version:   MassTransferDefaultVersion,
+		timestamp: getTimestamp(),
+	}
+}
+
+func (p *massTransferParams) Create() (*MassTransfer, error) {
+	if p.version != 1 && p.version != 3 {
+		return nil, errors.Errorf("unsupported mass transfer version %d", p.version)
+	}
+
+	if len(p.transfers) == 0 {
+		return nil, errors.New("no transfers set")
+	}
+
+	if len(p.transfers) > MaxMassTransfers {
+		return nil, errors.Errorf("a mass transfer can hold at most %d transfers", MaxMassTransfers)
+	}
+
+	for _, transfer := range p.transfers {
+		if len(transfer.Recipient) != crypto.AddressLength {
+			return nil, errors.New("invalid recipient")
+		}
+
+		if p.network != 0 && !crypto.IsValidAddress(transfer.Recipient, byte(p.network)) {
+			return nil, errors.New("recipient is not a valid address for the network")
+		}
+
+		if transfer.Amount <= 0 {
+			return nil, errors.New("amount must be positive")
+		}
+	}
+
+	if len(p.attachment) > MaxAttachmentLength {
+		return nil, errors.Errorf("attachment must not be longer than %d bytes", MaxAttachmentLength)
+	}
+
+	tx := &MassTransfer{
+		TransactionBase: TransactionBase{
+			Type:      TransactionTypeMassTransfer,
+			Version:   p.version,
+			Network:   p.network,
+			Fee:       p.fee,
+			Timestamp: p.timestamp,
+		},
+		Transfers:  p.transfers,
+		Attachment: p.attachment,
+	}
+
+	err := fillFee(tx, p.fees)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (p *massTransferParams) WithTransfer(recipient []byte, amount int64) *massTransferParams {
+	p.transfers = append(p.transfers, &MassTransferItem{
+		Recipient: recipient,
+		Amount:    amount,
+	})
+	return p
+}
+
+func (p *massTransferParams) WithTransfers(transfers ...*MassTransferItem) *massTransferParams {
+	p.transfers = append(p.transfers, transfers...)
+	return p
+}
+
+func (p *massTransferParams) WithAttachment(attachment []byte) *massTransferParams {
+	p.attachment = attachment
+	return p
+}
+
+func (p *massTransferParams) WithFee(fee int64) *massTransferParams {
+	p.fee = fee
+	return p
+}
+
+func (p *massTransferParams) WithTimestamp(timestamp int64) *massTransferParams {
+	p.timestamp = timestamp
+	return p
+}
+
+func (p *massTransferParams) WithVersion(version byte) *massTransferParams {
+	p.version = version
+	return p
+}
+
+func (p *massTransferParams) WithNetwork(network Network) *massTransferParams {
+	p.network = network
+	return p
+}
+
+func (p *massTransferParams) WithNetworkConfig(config *Config) *massTransferParams {
+	p.network = config.Network
+	p.fees = NewFeeCalculator(config.BasicConfig)
+	return p
+}
+
+type MassTransferItem struct {
+	Recipient []byte
+	Amount    int64
+}
+
+type MassTransfer struct {
+	TransactionBase
+
+	Transfers []*MassTransferItem
+
+	/**
+	 * Arbitrary data of at most 140 bytes
+	 */
+	Attachment []byte
+}
+
+/**
+ * Sum of the amounts of all transfers, excluding the fee
+ */
+func (t *MassTransfer) GetTotalAmount() int64 {
+	var total int64
+	for _, transfer := range t.Transfers {
+		total += transfer.Amount
+	}
+
+	return total
+}
+
+func (t *MassTransfer) GetBodyBytes() ([]byte, error) {
+	if len(t.SenderPublicKey) == 0 {
+		return nil, errors.New("first set sender before creating body bytes")
+	}
+
+	var values []interface{}
+
+	switch t.Version {
+	case 1:
+		values = []interface{}{
+			t.Type,
+			t.Version,
+			t.SenderPublicKey,
+		}
+		values = append(values, massTransferValues(t.Transfers)...)
+		values = append(values, t.Timestamp, t.Fee)
+	case 3:
+		if t.Network == 0 {
+			return nil, errors.New("network unknown")
+		}
+
+		values = []interface{}{
+			t.Type,
+			t.Version,
+			t.Network,
+			t.Timestamp,
+			t.SenderKeyType,
+			t.SenderPublicKey,
+			t.Fee,
+		}
+		values = append(values, massTransferValues(t.Transfers)...)
+	default:
+		return nil, errors.Errorf("unsupported mass transfer version %d", t.Version)
+	}
+
+	values = append(values, uint16(len(t.Attachment)), t.Attachment)
+
+	return writeBinary(values...)
+}
+
+func massTransferValues(transfers []*MassTransferItem) []interface{} {
+	values := []interface{}{uint16(len(transfers))}
+
+	for _, transfer := range transfers {
+		values = append(values, transfer.Recipient, transfer.Amount)
+	}
+
+	return values
+}
+
+func (t *MassTransfer) SignWith(account *Account) (*MassTransfer, error) {
+	err := account.SignTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *MassTransfer) SponsorWith(account *Account) (*MassTransfer, error) {
+	err := account.SponsorTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type massTransferItemJSON struct {
+	Recipient string `json:"recipient"`
+	Amount    int64  `json:"amount"`
+}
+
+type massTransferJSON struct {
+	transactionBaseJSON
+	Transfers  []*massTransferItemJSON `json:"transfers"`
+	Attachment string                  `json:"attachment"`
+}
+
+func (t *MassTransfer) MarshalJSON() ([]byte, error) {
+	transfers := make([]*massTransferItemJSON, len(t.Transfers))
+	for i, transfer := range t.Transfers {
+		transfers[i] = &massTransferItemJSON{
+			Recipient: crypto.Base58Encode(transfer.Recipient),
+			Amount:    transfer.Amount,
+		}
+	}
+
+	return json.Marshal(&massTransferJSON{
+		transactionBaseJSON: t.toJSON(),
+		Transfers:           transfers,
+		Attachment:          crypto.Base58Encode(t.Attachment),
+	})
+}
+
+func (t *MassTransfer) UnmarshalJSON(data []byte) error {
+	res := new(massTransferJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	err = t.fromJSON(&res.transactionBaseJSON)
+	if err != nil {
+		return err
+	}
+
+	t.Transfers = make([]*MassTransferItem, len(res.Transfers))
+	for i, transfer := range res.Transfers {
+		recipient, err := decodeBase58("recipient", transfer.Recipient)
+		if err != nil {
+			return err
+		}
+
+		t.Transfers[i] = &MassTransferItem{
+			Recipient: recipient,
+			Amount:    transfer.Amount,
+		}
+	}
+
+	t.Attachment, err = decodeBase58("attachment", res.Attachment)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package lto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestMassTransfer_GetBodyBytes(t *testing.T) {
+	publicKey := crypto.Base58Decode("FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y")
+	recipient := crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")
+
+	tests := []struct {
+		name    string
+		version byte
+		want    []byte
+	}{
+		{
+			name:    "should serialize a v1 mass transfer",
+			version: 1,
+			want: concat(
+				[]byte{11, 1},
+				publicKey,
+				[]byte{0, 2},
+				recipient,
+				[]byte{0, 0, 0, 0, 0x3b, 0x9a, 0xca, 0x00},
+				recipient,
+				[]byte{0, 0, 0, 0, 0x05, 0xf5, 0xe1, 0x00},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{0, 0, 0, 0, 0x07, 0x27, 0x0e, 0x00},
+				[]byte{0, 2, 'h', 'i'},
+			),
+		},
+		{
+			name:    "should serialize a v3 mass transfer",
+			version: 3,
+			want: concat(
+				[]byte{11, 3, 'T'},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{1},
+				publicKey,
+				[]byte{0, 0, 0, 0, 0x07, 0x27, 0x0e, 0x00},
+				[]byte{0, 2},
+				recipient,
+				[]byte{0, 0, 0, 0, 0x3b, 0x9a, 0xca, 0x00},
+				recipient,
+				[]byte{0, 0, 0, 0, 0x05, 0xf5, 0xe1, 0x00},
+				[]byte{0, 2, 'h', 'i'},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := lto.NewAccount().
+				FromPrivateKey(crypto.Base58Decode("wJ4WH8dD88fSkNdFQRjaAhjFUZzZhV5yiDLDwNUnp6bYwRXrvWV8MJhQ9HL9uqMDG1n7XpTGZx7PafqaayQV8Rp")).
+				WithNetwork(lto.NetworkTest).
+				Create()
+			require.NoError(t, err)
+
+			tx, err := lto.NewMassTransfer().
+				WithVersion(tt.version).
+				WithTransfer(recipient, 1000000000).
+				WithTransfer(recipient, 100000000).
+				WithAttachment([]byte("hi")).
+				WithTimestamp(1519862400000).
+				Create()
+			require.NoError(t, err)
+			require.Equal(t, int64(1100000000), tx.GetTotalAmount())
+
+			tx, err = tx.SignWith(a)
+			require.NoError(t, err)
+
+			body, err := tx.GetBodyBytes()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, body)
+
+			valid, err := a.Verify(tx.Proofs[0], body)
+			require.NoError(t, err)
+			require.True(t, valid)
+		})
+	}
+}
+
+func Test_NewMassTransferCreate(t *testing.T) {
+	recipient := crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")
+
+	tests := []struct {
+		name      string
+		transfers int
+		fee       int64
+		wantFee   int64
+		wantErr   bool
+	}{
+		{
+			name:      "should calculate the fee for a single transfer",
+			transfers: 1,
+			wantFee:   110000000,
+		},
+		{
+			name:      "should calculate the fee for the maximum number of transfers",
+			transfers: lto.MaxMassTransfers,
+			wantFee:   1100000000,
+		},
+		{
+			name:      "should use a given fee",
+			transfers: 10,
+			fee:       500000000,
+			wantFee:   500000000,
+		},
+		{
+			name:      "should throw an error for too many transfers",
+			transfers: lto.MaxMassTransfers + 1,
+			wantErr:   true,
+		},
+		{
+			name:      "should throw an error without transfers",
+			transfers: 0,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := lto.NewMassTransfer().WithFee(tt.fee)
+			for i := 0; i < tt.transfers; i++ {
+				p.WithTransfer(recipient, 100)
+			}
+
+			tx, err := p.Create()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantFee, tx.Fee)
+		})
+	}
+}
+
+func TestMassTransfer_JSON(t *testing.T) {
+	a, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	tx, err := lto.NewMassTransfer().
+		WithTransfer(crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8"), 100).
+		Create()
+	require.NoError(t, err)
+
+	tx, err = tx.SignWith(a)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(tx)
+	require.NoError(t, err)
+
+	var raw map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &raw))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"recipient": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8", "amount": float64(100)},
+	}, raw["transfers"])
+
+	got := new(lto.MassTransfer)
+	require.NoError(t, json.Unmarshal(data, got))
+	require.Equal(t, tx.Transfers, got.Transfers)
+	require.Equal(t, tx.ID, got.ID)
+}
@@ -0,0 +1,237 @@
+package lto
+
+import (
+	"strings"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+/**
+ * List of 2048 words from the BIP39 specification
+ */
+type Wordlist []string
+
+var (
+	WordlistEnglish            = Wordlist(seedDictionary)
+	WordlistChineseSimplified  = Wordlist(seedDictionaryChineseSimplified)
+	WordlistChineseTraditional = Wordlist(seedDictionaryChineseTraditional)
+	WordlistCzech              = Wordlist(seedDictionaryCzech)
+	WordlistFrench             = Wordlist(seedDictionaryFrench)
+	WordlistItalian            = Wordlist(seedDictionaryItalian)
+	WordlistJapanese           = Wordlist(seedDictionaryJapanese)
+	WordlistKorean             = Wordlist(seedDictionaryKorean)
+	WordlistSpanish            = Wordlist(seedDictionarySpanish)
+)
+
+/**
+ * Wordlists tried by ValidateMnemonic, in order of preference when a phrase matches several
+ */
+var wordlists = []Wordlist{
+	WordlistEnglish,
+	WordlistChineseSimplified,
+	WordlistChineseTraditional,
+	WordlistCzech,
+	WordlistFrench,
+	WordlistItalian,
+	WordlistJapanese,
+	WordlistKorean,
+	WordlistSpanish,
+}
+
+const MnemonicMinEntropyBits = 128
+const MnemonicMaxEntropyBits = 256
+
+var ErrMnemonicChecksum = errors.New("invalid mnemonic checksum")
+
+/**
+ * Index of the word in the list, -1 if the list doesn't have it
+ */
+func (w Wordlist) Index(word string) int {
+	word = normalizeMnemonicWord(word)
+
+	for i, v := range w {
+		if v == word {
+			return i
+		}
+	}
+
+	return -1
+}
+
+/**
+ * Create a mnemonic for 128 to 256 bits of entropy, the last word holds the checksum
+ */
+func NewMnemonic(entropy []byte, wordlist Wordlist) (string, error) {
+	bits := len(entropy) * 8
+	if bits < MnemonicMinEntropyBits || bits > MnemonicMaxEntropyBits || bits%32 != 0 {
+		return "", errors.Errorf("entropy must be %d to %d bits and a multiple of 32", MnemonicMinEntropyBits, MnemonicMaxEntropyBits)
+	}
+
+	if len(wordlist) != 2048 {
+		return "", errors.Errorf("wordlist must have 2048 words, got %d", len(wordlist))
+	}
+
+	// the checksum is 1 bit per 32 bits of entropy, so it always fits in the first byte of the hash
+	data := append(append([]byte{}, entropy...), crypto.Sha256(entropy)[0])
+
+	words := make([]string, (bits+bits/32)/11)
+	for i := range words {
+		index := 0
+		for j := 0; j < 11; j++ {
+			index = index<<1 | readBit(data, i*11+j)
+		}
+
+		words[i] = wordlist[index]
+	}
+
+	// the BIP39 specification uses the ideographic space for Japanese
+	if &wordlist[0] == &WordlistJapanese[0] {
+		return strings.Join(words, "\u3000"), nil
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+/**
+ * Create a mnemonic with random entropy
+ */
+func GenerateMnemonic(bits int, wordlist Wordlist) (string, error) {
+	if bits < MnemonicMinEntropyBits || bits > MnemonicMaxEntropyBits || bits%32 != 0 {
+		return "", errors.Errorf("entropy must be %d to %d bits and a multiple of 32", MnemonicMinEntropyBits, MnemonicMaxEntropyBits)
+	}
+
+	entropy := make([]byte, bits/8)
+	_, err := Rand.Read(entropy)
+	if err != nil {
+		return "", err
+	}
+
+	return NewMnemonic(entropy, wordlist)
+}
+
+/**
+ * Decode the entropy of a mnemonic, checking that all words are in the list and that the checksum matches
+ */
+func MnemonicToEntropy(mnemonic string, wordlist Wordlist) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return nil, errors.Errorf("mnemonic must have 12, 15, 18, 21 or 24 words, got %d", len(words))
+	}
+
+	data := make([]byte, (len(words)*11+7)/8)
+	for i, word := range words {
+		index := wordlist.Index(word)
+		if index == -1 {
+			return nil, errors.Errorf("unknown word %q at position %d", word, i+1)
+		}
+
+		for j := 0; j < 11; j++ {
+			writeBit(data, i*11+j, index>>(10-j)&1)
+		}
+	}
+
+	bits := len(words) * 11 * 32 / 33
+	entropy := data[:bits/8]
+
+	checksum := crypto.Sha256(entropy)[0]
+	for i := 0; i < bits/32; i++ {
+		if readBit(data, bits+i) != readBit([]byte{checksum}, i) {
+			return nil, ErrMnemonicChecksum
+		}
+	}
+
+	return entropy, nil
+}
+
+/**
+ * Check the words and checksum of a mnemonic in any of the wordlists
+ */
+func ValidateMnemonic(mnemonic string) error {
+	_, err := MnemonicToEntropy(mnemonic, DetectWordlist(mnemonic))
+	return err
+}
+
+/**
+ * Wordlist with the most words of the mnemonic, so a mistyped word is reported against the right list
+ */
+func DetectWordlist(mnemonic string) Wordlist {
+	words := strings.Fields(mnemonic)
+
+	best, bestCount := WordlistEnglish, -1
+	for _, wordlist := range wordlists {
+		count := 0
+		for _, word := range words {
+			if wordlist.Index(word) != -1 {
+				count++
+			}
+		}
+
+		if count > bestCount {
+			best, bestCount = wordlist, count
+		}
+	}
+
+	return best
+}
+
+/**
+ * Words of a valid mnemonic as they appear in the wordlist, separated by a single space
+ */
+func normalizeMnemonic(mnemonic string) string {
+	words := strings.Fields(mnemonic)
+	for i, word := range words {
+		words[i] = normalizeMnemonicWord(word)
+	}
+
+	return strings.Join(words, " ")
+}
+
+/**
+ * Composed characters of the wordlists, which are stored decomposed (NFKD)
+ */
+var decomposedRunes = map[rune]string{
+	'á': "a\u0301", 'è': "e\u0300", 'é': "e\u0301", 'í': "i\u0301", 'ñ': "n\u0303", 'ó': "o\u0301", 'ú': "u\u0301",
+	'が': "\u304b\u3099", 'ぎ': "\u304d\u3099", 'ぐ': "\u304f\u3099", 'げ': "\u3051\u3099", 'ご': "\u3053\u3099",
+	'ざ': "\u3055\u3099", 'じ': "\u3057\u3099", 'ず': "\u3059\u3099", 'ぜ': "\u305b\u3099", 'ぞ': "\u305d\u3099",
+	'だ': "\u305f\u3099", 'づ': "\u3064\u3099", 'で': "\u3066\u3099", 'ど': "\u3068\u3099",
+	'ば': "\u306f\u3099", 'び': "\u3072\u3099", 'ぶ': "\u3075\u3099", 'べ': "\u3078\u3099", 'ぼ': "\u307b\u3099",
+	'ぱ': "\u306f\u309a", 'ぴ': "\u3072\u309a", 'ぷ': "\u3075\u309a", 'ぺ': "\u3078\u309a", 'ぽ': "\u307b\u309a",
+}
+
+/**
+ * Decompose a word like the wordlists, so phrases typed with composed characters are recognized
+ */
+func normalizeMnemonicWord(word string) string {
+	var b strings.Builder
+
+	for _, r := range word {
+		if d, ok := decomposedRunes[r]; ok {
+			b.WriteString(d)
+			continue
+		}
+
+		// hangul syllables decompose algorithmically into their leading, vowel and trailing jamo
+		if r >= 0xAC00 && r <= 0xD7A3 {
+			s := r - 0xAC00
+			b.WriteRune(0x1100 + s/588)
+			b.WriteRune(0x1161 + s%588/28)
+			if s%28 != 0 {
+				b.WriteRune(0x11A7 + s%28)
+			}
+			continue
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+func readBit(data []byte, i int) int {
+	return int(data[i/8]>>(7-uint(i%8))) & 1
+}
+
+func writeBit(data []byte, i int, bit int) {
+	data[i/8] |= byte(bit) << (7 - uint(i%8))
+}
@@ -0,0 +1,143 @@
+package lto_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestNewMnemonic(t *testing.T) {
+	tests := []struct {
+		name     string
+		entropy  string
+		mnemonic string
+	}{
+		{
+			name:     "should create 12 words for 128 bits",
+			entropy:  "00000000000000000000000000000000",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		},
+		{
+			name:     "should create 12 words with the checksum in the last word",
+			entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+		},
+		{
+			name:     "should create 18 words for 192 bits",
+			entropy:  "808080808080808080808080808080808080808080808080",
+			mnemonic: "letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter always",
+		},
+		{
+			name:     "should create 24 words for 256 bits",
+			entropy:  "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			mnemonic: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entropy, err := hex.DecodeString(tt.entropy)
+			require.NoError(t, err)
+
+			got, err := lto.NewMnemonic(entropy, lto.WordlistEnglish)
+			require.NoError(t, err)
+			require.Equal(t, tt.mnemonic, got)
+
+			decoded, err := lto.MnemonicToEntropy(got, lto.WordlistEnglish)
+			require.NoError(t, err)
+			require.Equal(t, entropy, decoded)
+		})
+	}
+
+	_, err := lto.NewMnemonic(make([]byte, 15), lto.WordlistEnglish)
+	require.Error(t, err)
+
+	_, err = lto.NewMnemonic(make([]byte, 36), lto.WordlistEnglish)
+	require.Error(t, err)
+
+	_, err = lto.NewMnemonic(make([]byte, 16), lto.WordlistEnglish[:2047])
+	require.EqualError(t, err, "wordlist must have 2048 words, got 2047")
+}
+
+func TestGenerateMnemonic(t *testing.T) {
+	for _, bits := range []int{128, 160, 192, 224, 256} {
+		mnemonic, err := lto.GenerateMnemonic(bits, lto.WordlistEnglish)
+		require.NoError(t, err)
+		require.Len(t, strings.Fields(mnemonic), bits/32*3)
+		require.NoError(t, lto.ValidateMnemonic(mnemonic))
+	}
+
+	japanese, err := lto.GenerateMnemonic(128, lto.WordlistJapanese)
+	require.NoError(t, err)
+	require.Len(t, strings.Split(japanese, "\u3000"), 12)
+	require.NoError(t, lto.ValidateMnemonic(japanese))
+
+	_, err = lto.GenerateMnemonic(100, lto.WordlistEnglish)
+	require.Error(t, err)
+}
+
+func TestValidateMnemonic(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		wantErr  string
+	}{
+		{
+			name:     "should accept a valid mnemonic",
+			mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+		},
+		{
+			name:     "should accept extra whitespace",
+			mnemonic: "  legal winner thank year wave sausage\tworth useful legal winner thank  yellow\n",
+		},
+		{
+			name:     "should accept a french mnemonic with composed accents",
+			mnemonic: "monument dépenser féroce entasser comédie ferveur optique sonnette codifier discuter dioxyde nerveux",
+		},
+		{
+			name:     "should accept a korean mnemonic with composed syllables",
+			mnemonic: "원고 물질 생일 부산 마요네즈 생활 일찍 큰절 동화책 반성 반드시 의식",
+		},
+		{
+			name:     "should accept a spanish mnemonic",
+			mnemonic: "ligero vista talar yogur venta queso yacer trozo ligero vista talar zafiro",
+		},
+		{
+			name:     "should report a mistyped word",
+			mnemonic: "legal winner thank year wave sausage wroth useful legal winner thank yellow",
+			wantErr:  `unknown word "wroth" at position 7`,
+		},
+		{
+			name:     "should report a wrong checksum",
+			mnemonic: "legal winner thank year wave sausage worth useful legal winner yellow thank",
+			wantErr:  lto.ErrMnemonicChecksum.Error(),
+		},
+		{
+			name:     "should report a wrong number of words",
+			mnemonic: "legal winner thank year wave sausage worth useful legal winner thank",
+			wantErr:  "mnemonic must have 12, 15, 18, 21 or 24 words, got 11",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := lto.ValidateMnemonic(tt.mnemonic)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDetectWordlist(t *testing.T) {
+	french := lto.DetectWordlist("monument dépenser féroce entasser comédie ferveur optique sonnette codifier discuter dioxyde nerveux")
+	require.Equal(t, lto.WordlistFrench, french)
+
+	english := lto.DetectWordlist("legal winner thank year wave sausage wroth useful legal winner thank yellow")
+	require.Equal(t, lto.WordlistEnglish, english)
+}
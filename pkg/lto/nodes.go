@@ -0,0 +1,270 @@
+package lto
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+/**
+ * Strategy for choosing the node a request is sent to
+ */
+type NodeSelection int
+
+const (
+	/**
+	 * Send requests to the first node that passes the health check and is not cooling down, in the order of the config
+	 */
+	NodeSelectionFirstHealthy NodeSelection = iota
+
+	/**
+	 * Spread requests over the nodes that are not cooling down
+	 */
+	NodeSelectionRoundRobin
+)
+
+const DefaultRetryCount = 3
+const DefaultRetryWaitTime = 100 * time.Millisecond
+const DefaultRetryMaxWaitTime = 2 * time.Second
+const DefaultNodeCooldown = 30 * time.Second
+const DefaultHealthCheckTimeout = 2 * time.Second
+
+/**
+ * Endpoint requested to check if a node is up
+ */
+const healthCheckPath = "/node/status"
+
+type node struct {
+	address     string
+	failedUntil time.Time
+
+	/**
+	 * Whether the node passed a health check or a request since it last failed
+	 */
+	healthy bool
+}
+
+/**
+ * Nodes of the config, a node that fails a request or health check is taken out of rotation for the cooldown.
+ * With several nodes, a node is health checked before it's first used and before it re-enters rotation.
+ */
+type nodePool struct {
+	mutex     sync.Mutex
+	nodes     []*node
+	selection NodeSelection
+	cooldown  time.Duration
+	next      int
+}
+
+func newNodePool(addresses []string, selection NodeSelection, cooldown time.Duration) (*nodePool, error) {
+	if len(addresses) == 0 {
+		return nil, errors.New("no node address set")
+	}
+
+	if selection != NodeSelectionFirstHealthy && selection != NodeSelectionRoundRobin {
+		return nil, errors.Errorf("invalid node selection %d", selection)
+	}
+
+	nodes := make([]*node, len(addresses))
+	for i, address := range addresses {
+		_, err := url.Parse(address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid node address %s", address)
+		}
+
+		nodes[i] = &node{address: strings.TrimRight(address, "/")}
+	}
+
+	return &nodePool{
+		nodes:     nodes,
+		selection: selection,
+		cooldown:  cooldown,
+	}, nil
+}
+
+/**
+ * Get the node for the next request and whether it must pass a health check first.
+ * If all nodes are cooling down, the node that comes out of cooldown first is used.
+ */
+func (p *nodePool) pick() (*node, bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := time.Now()
+	start := 0
+	if p.selection == NodeSelectionRoundRobin {
+		start = p.next
+	}
+
+	var fallback *node
+
+	for i := range p.nodes {
+		index := (start + i) % len(p.nodes)
+		n := p.nodes[index]
+
+		if !now.Before(n.failedUntil) {
+			p.next = index + 1
+			return n, !n.healthy && len(p.nodes) > 1
+		}
+
+		if fallback == nil || n.failedUntil.Before(fallback.failedUntil) {
+			fallback = n
+		}
+	}
+
+	return fallback, false
+}
+
+func (p *nodePool) markFailed(n *node) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	n.failedUntil = time.Now().Add(p.cooldown)
+	n.healthy = false
+}
+
+func (p *nodePool) markHealthy(n *node) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	n.failedUntil = time.Time{}
+	n.healthy = true
+}
+
+/**
+ * Transport sending each request to a node of the pool.
+ * Failed GET requests are retried with exponential backoff, preferably on another node.
+ */
+type nodeTransport struct {
+	pool      *nodePool
+	transport http.RoundTripper
+
+	/**
+	 * Address the requests are created with, which is replaced by the address of the picked node
+	 */
+	hostURL string
+
+	retryCount         int
+	retryWaitTime      time.Duration
+	retryMaxWaitTime   time.Duration
+	healthCheckTimeout time.Duration
+}
+
+func (t *nodeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	wait := t.retryWaitTime
+
+	for attempt := 0; ; attempt++ {
+		n := t.pickHealthy(ctx)
+
+		nodeReq, err := t.rewrite(req, n)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := t.transport.RoundTrip(nodeReq)
+		if ctx.Err() != nil {
+			return res, err
+		}
+
+		if err == nil && res.StatusCode < http.StatusInternalServerError {
+			t.pool.markHealthy(n)
+			return res, nil
+		}
+
+		t.pool.markFailed(n)
+
+		if attempt >= t.retryCount || req.Method != http.MethodGet {
+			return res, err
+		}
+
+		if res != nil {
+			_, _ = io.Copy(ioutil.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		wait *= 2
+		if wait > t.retryMaxWaitTime {
+			wait = t.retryMaxWaitTime
+		}
+	}
+}
+
+/**
+ * Pick a node, skipping nodes that fail the health check.
+ * Each node is checked at most once, as a node that fails goes into cooldown.
+ */
+func (t *nodeTransport) pickHealthy(ctx context.Context) *node {
+	n, check := t.pool.pick()
+
+	for i := 0; check && i < len(t.pool.nodes); i++ {
+		if t.checkHealth(ctx, n) {
+			t.pool.markHealthy(n)
+			return n
+		}
+
+		if ctx.Err() != nil {
+			return n
+		}
+
+		t.pool.markFailed(n)
+		n, check = t.pool.pick()
+	}
+
+	return n
+}
+
+/**
+ * Lightweight request to the node, which is up if it responds without a server error
+ */
+func (t *nodeTransport) checkHealth(ctx context.Context, n *node) bool {
+	ctx, cancel := context.WithTimeout(ctx, t.healthCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, n.address+healthCheckPath, nil)
+	if err != nil {
+		return false
+	}
+
+	res, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return false
+	}
+
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+	_ = res.Body.Close()
+
+	return res.StatusCode < http.StatusInternalServerError
+}
+
+func (t *nodeTransport) rewrite(req *http.Request, n *node) (*http.Request, error) {
+	address := req.URL.String()
+	rest := strings.TrimPrefix(address, t.hostURL)
+	if rest == address || (rest != "" && rest[0] != '/' && rest[0] != '?') {
+		return req, nil
+	}
+
+	u, err := url.Parse(n.address + rest)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeReq := req.Clone(req.Context())
+	nodeReq.URL = u
+	nodeReq.Host = u.Host
+
+	return nodeReq, nil
+}
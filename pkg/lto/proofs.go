@@ -0,0 +1,123 @@
+package lto
+
+import (
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+const MaxProofs = 8
+
+/**
+ * Signatures of the body bytes of a transaction, one for each signing account
+ */
+type Proofs [][]byte
+
+func (p *Proofs) Add(proof []byte) error {
+	if len(proof) != crypto.SignatureLength {
+		return errors.New("invalid proof size")
+	}
+
+	if len(*p) >= MaxProofs {
+		return errors.Errorf("a transaction can hold at most %d proofs", MaxProofs)
+	}
+
+	*p = append(*p, proof)
+
+	return nil
+}
+
+/**
+ * Check that every proof is a valid signature of the message by one of the public keys.
+ * A public key can account for a single proof only.
+ */
+func (p Proofs) Verify(message []byte, publicKeys ...[]byte) error {
+	if len(p) == 0 {
+		return errors.New("transaction has no proofs")
+	}
+
+	used := make([]bool, len(publicKeys))
+
+	for i, proof := range p {
+		found := false
+
+		for j, publicKey := range publicKeys {
+			if used[j] {
+				continue
+			}
+
+			valid, err := crypto.VerifySignature(message, proof, publicKey)
+			if err != nil {
+				return errors.Wrapf(err, "failed to verify proof %d", i)
+			}
+
+			if valid {
+				used[j] = true
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return errors.Errorf("proof %d is not signed by any of the expected public keys", i)
+		}
+	}
+
+	return nil
+}
+
+/**
+ * Check the proofs of the transaction against the public keys of the signers.
+ * Without public keys the first proof must be signed by the sender and, if the transaction is sponsored, the second
+ * by the sponsor. Proofs of other signers are only checked when their public keys are given.
+ */
+func VerifyProofs(tx Transaction, publicKeys ...[]byte) error {
+	base := tx.GetBase()
+
+	body, err := tx.GetBodyBytes()
+	if err != nil {
+		return err
+	}
+
+	if len(publicKeys) != 0 {
+		return base.Proofs.Verify(body, publicKeys...)
+	}
+
+	if len(base.Proofs) == 0 {
+		return errors.New("transaction has no proofs")
+	}
+
+	err = base.Proofs.verifyAt(body, 0, "sender", base.SenderKeyType, base.SenderPublicKey)
+	if err != nil {
+		return err
+	}
+
+	if len(base.SponsorPublicKey) != 0 {
+		return base.Proofs.verifyAt(body, 1, "sponsor", base.SponsorKeyType, base.SponsorPublicKey)
+	}
+
+	return nil
+}
+
+/**
+ * Check that the proof at the index is signed by the public key of the signer
+ */
+func (p Proofs) verifyAt(message []byte, i int, signer string, keyType KeyType, publicKey []byte) error {
+	if keyType != KeyTypeED25519 {
+		return errors.Errorf("verifying %s signatures is not supported", keyType)
+	}
+
+	if len(p) <= i {
+		return errors.Errorf("transaction is not signed by the %s", signer)
+	}
+
+	valid, err := crypto.VerifySignature(message, p[i], publicKey)
+	if err != nil {
+		return errors.Wrapf(err, "failed to verify proof %d", i)
+	}
+
+	if !valid {
+		return errors.Errorf("proof %d is not signed by the %s", i, signer)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package lto_test
+
+import (
+	"testing"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestProofs_Add(t *testing.T) {
+	var proofs lto.Proofs
+
+	err := proofs.Add(make([]byte, crypto.SignatureLength))
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+
+	err = proofs.Add([]byte{1, 2, 3})
+	require.Error(t, err)
+
+	for len(proofs) < lto.MaxProofs {
+		require.NoError(t, proofs.Add(make([]byte, crypto.SignatureLength)))
+	}
+	err = proofs.Add(make([]byte, crypto.SignatureLength))
+	require.Error(t, err)
+}
+
+func TestProofs_Verify(t *testing.T) {
+	alice, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	bob, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	carol, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	tx, err := lto.NewTransfer().
+		WithRecipient(carol.Address).
+		WithAmount(100).
+		Create()
+	require.NoError(t, err)
+
+	tx, err = tx.SignWith(alice)
+	require.NoError(t, err)
+
+	tx, err = tx.SignWith(bob)
+	require.NoError(t, err)
+
+	body, err := tx.GetBodyBytes()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		publicKeys [][]byte
+		wantErr    bool
+	}{
+		{
+			name:       "should verify proofs of all signers",
+			publicKeys: [][]byte{alice.Sign.PublicKey, bob.Sign.PublicKey},
+		},
+		{
+			name:       "should verify proofs regardless of the order of the public keys",
+			publicKeys: [][]byte{carol.Sign.PublicKey, bob.Sign.PublicKey, alice.Sign.PublicKey},
+		},
+		{
+			name:       "should throw an error if a signer is not expected",
+			publicKeys: [][]byte{alice.Sign.PublicKey, carol.Sign.PublicKey},
+			wantErr:    true,
+		},
+		{
+			name:       "should throw an error if a public key is used for more than one proof",
+			publicKeys: [][]byte{alice.Sign.PublicKey},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tx.Proofs.Verify(body, tt.publicKeys...)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestVerifyProofs(t *testing.T) {
+	sender, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	sponsor, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	tx, err := lto.NewAnchor().WithAnchors(crypto.Blake2b([]byte("hello"))).Create()
+	require.NoError(t, err)
+
+	err = lto.VerifyProofs(tx)
+	require.Error(t, err)
+
+	tx, err = tx.SignWith(sender)
+	require.NoError(t, err)
+
+	tx, err = tx.SponsorWith(sponsor)
+	require.NoError(t, err)
+
+	err = lto.VerifyProofs(tx)
+	require.NoError(t, err)
+
+	tx.Fee++
+	err = lto.VerifyProofs(tx)
+	require.Error(t, err)
+	tx.Fee--
+
+	proofs := tx.Proofs
+
+	tx.Proofs = lto.Proofs{proofs[1]}
+	err = lto.VerifyProofs(tx)
+	require.EqualError(t, err, "proof 0 is not signed by the sender")
+
+	tx.Proofs = lto.Proofs{proofs[1], proofs[0]}
+	err = lto.VerifyProofs(tx)
+	require.EqualError(t, err, "proof 0 is not signed by the sender")
+
+	tx.Proofs = lto.Proofs{proofs[0]}
+	err = lto.VerifyProofs(tx)
+	require.EqualError(t, err, "transaction is not signed by the sponsor")
+}
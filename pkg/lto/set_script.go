@@ -0,0 +1,219 @@
+package lto
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+const SetScriptFee int64 = 500000000
+const SetScriptDefaultVersion byte = 3
+const MaxScriptLength = 32 * 1024
+
+type setScriptParams struct {
+	version   byte
+	network   Network
+	script    string
+	fee       int64
+	timestamp int64
+	fees      *FeeCalculator
+}
+
+func NewSetScript() *setScriptParams {
+	return &setScriptParams{
+		version:   SetScriptDefaultVersion,
+		timestamp: getTimestamp(),
+	}
+}
+
+func (p *setScriptParams) Create() (*SetScript, error) {
+	if p.version != 1 && p.version != 3 {
+		return nil, errors.Errorf("unsupported set script version %d", p.version)
+	}
+
+	script, err := decodeScript(p.script)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(script) > MaxScriptLength {
+		return nil, errors.Errorf("script must not be longer than %d bytes", MaxScriptLength)
+	}
+
+	tx := &SetScript{
+		TransactionBase: TransactionBase{
+			Type:      TransactionTypeSetScript,
+			Version:   p.version,
+			Network:   p.network,
+			Fee:       p.fee,
+			Timestamp: p.timestamp,
+		},
+		Script: script,
+	}
+
+	err = fillFee(tx, p.fees)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+/**
+ * Script as returned by API.UtilsCompile, either plain base64 or prefixed with "base64:".
+ * An empty script removes the script from the account.
+ */
+func (p *setScriptParams) WithScript(script string) *setScriptParams {
+	p.script = script
+	return p
+}
+
+func (p *setScriptParams) WithFee(fee int64) *setScriptParams {
+	p.fee = fee
+	return p
+}
+
+func (p *setScriptParams) WithTimestamp(timestamp int64) *setScriptParams {
+	p.timestamp = timestamp
+	return p
+}
+
+func (p *setScriptParams) WithVersion(version byte) *setScriptParams {
+	p.version = version
+	return p
+}
+
+func (p *setScriptParams) WithNetwork(network Network) *setScriptParams {
+	p.network = network
+	return p
+}
+
+func (p *setScriptParams) WithNetworkConfig(config *Config) *setScriptParams {
+	p.network = config.Network
+	p.fees = NewFeeCalculator(config.BasicConfig)
+	return p
+}
+
+func decodeScript(script string) ([]byte, error) {
+	if script == "" {
+		return nil, nil
+	}
+
+	b, err := crypto.Base64Decode(strings.TrimPrefix(script, "base64:"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid script")
+	}
+
+	return b, nil
+}
+
+func encodeScript(script []byte) *string {
+	if len(script) == 0 {
+		return nil
+	}
+
+	s := "base64:" + crypto.Base64Encode(script)
+	return &s
+}
+
+type SetScript struct {
+	TransactionBase
+
+	/**
+	 * Compiled script, nil if the script is removed from the account
+	 */
+	Script []byte
+}
+
+func (t *SetScript) GetBodyBytes() ([]byte, error) {
+	if len(t.SenderPublicKey) == 0 {
+		return nil, errors.New("first set sender before creating body bytes")
+	}
+
+	if t.Network == 0 {
+		return nil, errors.New("network unknown")
+	}
+
+	switch t.Version {
+	case 1:
+		values := []interface{}{
+			t.Type,
+			t.Version,
+			t.Network,
+			t.SenderPublicKey,
+		}
+		values = append(values, optionalBytesValues(t.Script)...)
+		values = append(values, t.Fee, t.Timestamp)
+
+		return writeBinary(values...)
+	case 3:
+		return writeBinary(
+			t.Type,
+			t.Version,
+			t.Network,
+			t.Timestamp,
+			t.SenderKeyType,
+			t.SenderPublicKey,
+			t.Fee,
+			uint16(len(t.Script)),
+			t.Script,
+		)
+	default:
+		return nil, errors.Errorf("unsupported set script version %d", t.Version)
+	}
+}
+
+func (t *SetScript) SignWith(account *Account) (*SetScript, error) {
+	err := account.SignTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *SetScript) SponsorWith(account *Account) (*SetScript, error) {
+	err := account.SponsorTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type setScriptJSON struct {
+	transactionBaseJSON
+	Script *string `json:"script"`
+}
+
+func (t *SetScript) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&setScriptJSON{
+		transactionBaseJSON: t.toJSON(),
+		Script:              encodeScript(t.Script),
+	})
+}
+
+func (t *SetScript) UnmarshalJSON(data []byte) error {
+	res := new(setScriptJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	err = t.fromJSON(&res.transactionBaseJSON)
+	if err != nil {
+		return err
+	}
+
+	if res.Script != nil {
+		t.Script, err = decodeScript(*res.Script)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package lto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestSetScript_GetBodyBytes(t *testing.T) {
+	publicKey := crypto.Base58Decode("FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y")
+
+	tests := []struct {
+		name    string
+		version byte
+		script  string
+		want    []byte
+	}{
+		{
+			name:    "should serialize a v1 set script",
+			version: 1,
+			script:  "base64:AQID",
+			want: concat(
+				[]byte{13, 1, 'T'},
+				publicKey,
+				[]byte{1, 0, 3, 1, 2, 3},
+				[]byte{0, 0, 0, 0, 0x1d, 0xcd, 0x65, 0x00},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+			),
+		},
+		{
+			name:    "should serialize a v1 set script removing the script",
+			version: 1,
+			want: concat(
+				[]byte{13, 1, 'T'},
+				publicKey,
+				[]byte{0},
+				[]byte{0, 0, 0, 0, 0x1d, 0xcd, 0x65, 0x00},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+			),
+		},
+		{
+			name:    "should serialize a v3 set script",
+			version: 3,
+			script:  "AQID",
+			want: concat(
+				[]byte{13, 3, 'T'},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{1},
+				publicKey,
+				[]byte{0, 0, 0, 0, 0x1d, 0xcd, 0x65, 0x00},
+				[]byte{0, 3, 1, 2, 3},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := lto.NewAccount().
+				FromPrivateKey(crypto.Base58Decode("wJ4WH8dD88fSkNdFQRjaAhjFUZzZhV5yiDLDwNUnp6bYwRXrvWV8MJhQ9HL9uqMDG1n7XpTGZx7PafqaayQV8Rp")).
+				WithNetwork(lto.NetworkTest).
+				Create()
+			require.NoError(t, err)
+
+			tx, err := lto.NewSetScript().
+				WithVersion(tt.version).
+				WithScript(tt.script).
+				WithTimestamp(1519862400000).
+				Create()
+			require.NoError(t, err)
+
+			tx, err = tx.SignWith(a)
+			require.NoError(t, err)
+
+			body, err := tx.GetBodyBytes()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, body)
+		})
+	}
+}
+
+func TestNewSetScript_Create(t *testing.T) {
+	_, err := lto.NewSetScript().WithScript("base64:not base64").Create()
+	require.Error(t, err)
+
+	_, err = lto.NewSetScript().WithScript(crypto.Base64Encode(make([]byte, lto.MaxScriptLength+1))).Create()
+	require.Error(t, err)
+}
+
+func TestSetScript_MarshalJSON(t *testing.T) {
+	a, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	for _, script := range []string{"base64:AQID", ""} {
+		tx, err := lto.NewSetScript().WithScript(script).Create()
+		require.NoError(t, err)
+
+		tx, err = tx.SignWith(a)
+		require.NoError(t, err)
+
+		data, err := json.Marshal(tx)
+		require.NoError(t, err)
+
+		if script == "" {
+			require.Contains(t, string(data), `"script":null`)
+		} else {
+			require.Contains(t, string(data), `"script":"base64:AQID"`)
+		}
+
+		res := new(lto.SetScript)
+		err = json.Unmarshal(data, res)
+		require.NoError(t, err)
+		require.Equal(t, tx.Script, res.Script)
+		require.Equal(t, tx.ID, res.ID)
+	}
+}
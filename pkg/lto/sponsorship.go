@@ -0,0 +1,337 @@
+package lto
+
+import (
+	"encoding/json"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+const SponsorshipFee int64 = 500000000
+const SponsorshipDefaultVersion byte = 3
+
+type sponsorshipParams struct {
+	version   byte
+	network   Network
+	recipient []byte
+	fee       int64
+	timestamp int64
+	fees      *FeeCalculator
+}
+
+func NewSponsorship() *sponsorshipParams {
+	return &sponsorshipParams{
+		version:   SponsorshipDefaultVersion,
+		timestamp: getTimestamp(),
+	}
+}
+
+func (p *sponsorshipParams) Create() (*Sponsorship, error) {
+	if p.version != 1 && p.version != 3 {
+		return nil, errors.Errorf("unsupported sponsorship version %d", p.version)
+	}
+
+	err := validateSponsorship(p.network, p.recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &Sponsorship{
+		TransactionBase: TransactionBase{
+			Type:      TransactionTypeSponsorship,
+			Version:   p.version,
+			Network:   p.network,
+			Fee:       p.fee,
+			Timestamp: p.timestamp,
+		},
+		Recipient: p.recipient,
+	}
+
+	err = fillFee(tx, p.fees)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (p *sponsorshipParams) WithRecipient(recipient []byte) *sponsorshipParams {
+	p.recipient = recipient
+	return p
+}
+
+func (p *sponsorshipParams) WithFee(fee int64) *sponsorshipParams {
+	p.fee = fee
+	return p
+}
+
+func (p *sponsorshipParams) WithTimestamp(timestamp int64) *sponsorshipParams {
+	p.timestamp = timestamp
+	return p
+}
+
+func (p *sponsorshipParams) WithVersion(version byte) *sponsorshipParams {
+	p.version = version
+	return p
+}
+
+func (p *sponsorshipParams) WithNetwork(network Network) *sponsorshipParams {
+	p.network = network
+	return p
+}
+
+func (p *sponsorshipParams) WithNetworkConfig(config *Config) *sponsorshipParams {
+	p.network = config.Network
+	p.fees = NewFeeCalculator(config.BasicConfig)
+	return p
+}
+
+func validateSponsorship(network Network, recipient []byte) error {
+	if len(recipient) != crypto.AddressLength {
+		return errors.New("invalid recipient")
+	}
+
+	if network != 0 && !crypto.IsValidAddress(recipient, byte(network)) {
+		return errors.New("recipient is not a valid address for the network")
+	}
+
+	return nil
+}
+
+func getSponsorshipBodyBytes(base *TransactionBase, recipient []byte) ([]byte, error) {
+	if len(base.SenderPublicKey) == 0 {
+		return nil, errors.New("first set sender before creating body bytes")
+	}
+
+	if base.Network == 0 {
+		return nil, errors.New("network unknown")
+	}
+
+	switch base.Version {
+	case 1:
+		return writeBinary(
+			base.Type,
+			base.Version,
+			base.Network,
+			base.SenderPublicKey,
+			recipient,
+			base.Timestamp,
+			base.Fee,
+		)
+	case 3:
+		return writeBinary(
+			base.Type,
+			base.Version,
+			base.Network,
+			base.Timestamp,
+			base.SenderKeyType,
+			base.SenderPublicKey,
+			base.Fee,
+			recipient,
+		)
+	default:
+		return nil, errors.Errorf("unsupported sponsorship version %d", base.Version)
+	}
+}
+
+type Sponsorship struct {
+	TransactionBase
+
+	/**
+	 * Address whose transaction fees are paid by the sender
+	 */
+	Recipient []byte
+}
+
+func (t *Sponsorship) GetBodyBytes() ([]byte, error) {
+	return getSponsorshipBodyBytes(&t.TransactionBase, t.Recipient)
+}
+
+func (t *Sponsorship) SignWith(account *Account) (*Sponsorship, error) {
+	err := account.SignTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *Sponsorship) SponsorWith(account *Account) (*Sponsorship, error) {
+	err := account.SponsorTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type sponsorshipJSON struct {
+	transactionBaseJSON
+	Recipient string `json:"recipient"`
+}
+
+func (t *Sponsorship) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&sponsorshipJSON{
+		transactionBaseJSON: t.toJSON(),
+		Recipient:           crypto.Base58Encode(t.Recipient),
+	})
+}
+
+func (t *Sponsorship) UnmarshalJSON(data []byte) error {
+	res := new(sponsorshipJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	err = t.fromJSON(&res.transactionBaseJSON)
+	if err != nil {
+		return err
+	}
+
+	t.Recipient, err = decodeBase58("recipient", res.Recipient)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+const CancelSponsorshipFee int64 = 500000000
+const CancelSponsorshipDefaultVersion byte = 3
+
+type cancelSponsorshipParams struct {
+	version   byte
+	network   Network
+	recipient []byte
+	fee       int64
+	timestamp int64
+	fees      *FeeCalculator
+}
+
+func NewCancelSponsorship() *cancelSponsorshipParams {
+	return &cancelSponsorshipParams{
+		version:   CancelSponsorshipDefaultVersion,
+		timestamp: getTimestamp(),
+	}
+}
+
+func (p *cancelSponsorshipParams) Create() (*CancelSponsorship, error) {
+	if p.version != 1 && p.version != 3 {
+		return nil, errors.Errorf("unsupported cancel sponsorship version %d", p.version)
+	}
+
+	err := validateSponsorship(p.network, p.recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &CancelSponsorship{
+		TransactionBase: TransactionBase{
+			Type:      TransactionTypeCancelSponsorship,
+			Version:   p.version,
+			Network:   p.network,
+			Fee:       p.fee,
+			Timestamp: p.timestamp,
+		},
+		Recipient: p.recipient,
+	}
+
+	err = fillFee(tx, p.fees)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (p *cancelSponsorshipParams) WithRecipient(recipient []byte) *cancelSponsorshipParams {
+	p.recipient = recipient
+	return p
+}
+
+func (p *cancelSponsorshipParams) WithFee(fee int64) *cancelSponsorshipParams {
+	p.fee = fee
+	return p
+}
+
+func (p *cancelSponsorshipParams) WithTimestamp(timestamp int64) *cancelSponsorshipParams {
+	p.timestamp = timestamp
+	return p
+}
+
+func (p *cancelSponsorshipParams) WithVersion(version byte) *cancelSponsorshipParams {
+	p.version = version
+	return p
+}
+
+func (p *cancelSponsorshipParams) WithNetwork(network Network) *cancelSponsorshipParams {
+	p.network = network
+	return p
+}
+
+func (p *cancelSponsorshipParams) WithNetworkConfig(config *Config) *cancelSponsorshipParams {
+	p.network = config.Network
+	p.fees = NewFeeCalculator(config.BasicConfig)
+	return p
+}
+
+type CancelSponsorship struct {
+	TransactionBase
+
+	/**
+	 * Address that is no longer sponsored by the sender
+	 */
+	Recipient []byte
+}
+
+func (t *CancelSponsorship) GetBodyBytes() ([]byte, error) {
+	return getSponsorshipBodyBytes(&t.TransactionBase, t.Recipient)
+}
+
+func (t *CancelSponsorship) SignWith(account *Account) (*CancelSponsorship, error) {
+	err := account.SignTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *CancelSponsorship) SponsorWith(account *Account) (*CancelSponsorship, error) {
+	err := account.SponsorTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *CancelSponsorship) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&sponsorshipJSON{
+		transactionBaseJSON: t.toJSON(),
+		Recipient:           crypto.Base58Encode(t.Recipient),
+	})
+}
+
+func (t *CancelSponsorship) UnmarshalJSON(data []byte) error {
+	res := new(sponsorshipJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	err = t.fromJSON(&res.transactionBaseJSON)
+	if err != nil {
+		return err
+	}
+
+	t.Recipient, err = decodeBase58("recipient", res.Recipient)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package lto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestSponsorship_GetBodyBytes(t *testing.T) {
+	publicKey := crypto.Base58Decode("FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y")
+	recipient := crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")
+
+	tests := []struct {
+		name    string
+		version byte
+		cancel  bool
+		want    []byte
+	}{
+		{
+			name:    "should serialize a v1 sponsorship",
+			version: 1,
+			want: concat(
+				[]byte{18, 1, 'T'},
+				publicKey,
+				recipient,
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{0, 0, 0, 0, 0x1d, 0xcd, 0x65, 0x00},
+			),
+		},
+		{
+			name:    "should serialize a v3 sponsorship",
+			version: 3,
+			want: concat(
+				[]byte{18, 3, 'T'},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{1},
+				publicKey,
+				[]byte{0, 0, 0, 0, 0x1d, 0xcd, 0x65, 0x00},
+				recipient,
+			),
+		},
+		{
+			name:    "should serialize a v3 cancel sponsorship",
+			version: 3,
+			cancel:  true,
+			want: concat(
+				[]byte{19, 3, 'T'},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{1},
+				publicKey,
+				[]byte{0, 0, 0, 0, 0x1d, 0xcd, 0x65, 0x00},
+				recipient,
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := lto.NewAccount().
+				FromPrivateKey(crypto.Base58Decode("wJ4WH8dD88fSkNdFQRjaAhjFUZzZhV5yiDLDwNUnp6bYwRXrvWV8MJhQ9HL9uqMDG1n7XpTGZx7PafqaayQV8Rp")).
+				WithNetwork(lto.NetworkTest).
+				Create()
+			require.NoError(t, err)
+
+			var tx lto.Transaction
+			if tt.cancel {
+				tx, err = lto.NewCancelSponsorship().
+					WithVersion(tt.version).
+					WithRecipient(recipient).
+					WithTimestamp(1519862400000).
+					Create()
+			} else {
+				tx, err = lto.NewSponsorship().
+					WithVersion(tt.version).
+					WithRecipient(recipient).
+					WithTimestamp(1519862400000).
+					Create()
+			}
+			require.NoError(t, err)
+
+			err = a.SignTransaction(tx)
+			require.NoError(t, err)
+
+			body, err := tx.GetBodyBytes()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, body)
+		})
+	}
+}
+
+func TestAccount_SponsorTransaction(t *testing.T) {
+	recipient := crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")
+
+	tests := []struct {
+		name    string
+		version byte
+		sign    bool
+		wantErr bool
+	}{
+		{
+			name:    "should add the sponsor proof after the sender proof",
+			version: 3,
+			sign:    true,
+		},
+		{
+			name:    "should throw an error when the sender has not signed",
+			version: 3,
+			wantErr: true,
+		},
+		{
+			name:    "should throw an error for a version without sponsor support",
+			version: 2,
+			sign:    true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+			require.NoError(t, err)
+
+			sponsor, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+			require.NoError(t, err)
+
+			tx, err := lto.NewTransfer().
+				WithVersion(tt.version).
+				WithRecipient(recipient).
+				WithAmount(100).
+				Create()
+			require.NoError(t, err)
+
+			if tt.sign {
+				tx, err = tx.SignWith(sender)
+				require.NoError(t, err)
+			}
+
+			tx, err = tx.SponsorWith(sponsor)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			body, err := tx.GetBodyBytes()
+			require.NoError(t, err)
+
+			require.Len(t, tx.Proofs, 2)
+			valid, err := sender.Verify(tx.Proofs[0], body)
+			require.NoError(t, err)
+			require.True(t, valid)
+			valid, err = sponsor.Verify(tx.Proofs[1], body)
+			require.NoError(t, err)
+			require.True(t, valid)
+
+			data, err := json.Marshal(tx)
+			require.NoError(t, err)
+
+			var raw map[string]interface{}
+			require.NoError(t, json.Unmarshal(data, &raw))
+			require.Equal(t, crypto.Base58Encode(sponsor.Address), raw["sponsor"])
+			require.Equal(t, "ed25519", raw["sponsorKeyType"])
+			require.Equal(t, crypto.Base58Encode(sponsor.Sign.PublicKey), raw["sponsorPublicKey"])
+
+			got := new(lto.Transfer)
+			require.NoError(t, json.Unmarshal(data, got))
+			require.Equal(t, sponsor.Sign.PublicKey, got.SponsorPublicKey)
+			require.Equal(t, []byte(sponsor.Address), got.Sponsor)
+		})
+	}
+}
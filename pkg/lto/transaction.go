@@ -0,0 +1,452 @@
+package lto
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+type TransactionType byte
+
+const (
+	TransactionTypeGenesis           TransactionType = 1
+	TransactionTypeTransfer          TransactionType = 4
+	TransactionTypeLease             TransactionType = 8
+	TransactionTypeCancelLease       TransactionType = 9
+	TransactionTypeMassTransfer      TransactionType = 11
+	TransactionTypeData              TransactionType = 12
+	TransactionTypeSetScript         TransactionType = 13
+	TransactionTypeAnchor            TransactionType = 15
+	TransactionTypeAssociation       TransactionType = 16
+	TransactionTypeRevokeAssociation TransactionType = 17
+	TransactionTypeSponsorship       TransactionType = 18
+	TransactionTypeCancelSponsorship TransactionType = 19
+)
+
+type KeyType byte
+
+const (
+	KeyTypeED25519   KeyType = 1
+	KeyTypeSecp256k1 KeyType = 2
+	KeyTypeSecp256r1 KeyType = 3
+)
+
+func (k KeyType) String() string {
+	switch k {
+	case KeyTypeED25519:
+		return "ed25519"
+	case KeyTypeSecp256k1:
+		return "secp256k1"
+	case KeyTypeSecp256r1:
+		return "secp256r1"
+	default:
+		return ""
+	}
+}
+
+func parseKeyType(s string) (KeyType, error) {
+	switch s {
+	case "", "ed25519":
+		return KeyTypeED25519, nil
+	case "secp256k1":
+		return KeyTypeSecp256k1, nil
+	case "secp256r1":
+		return KeyTypeSecp256r1, nil
+	default:
+		return 0, errors.Errorf("unsupported key type %s", s)
+	}
+}
+
+type Transaction interface {
+	json.Marshaler
+	json.Unmarshaler
+
+	/**
+	 * Fields shared by all transaction types
+	 */
+	GetBase() *TransactionBase
+
+	/**
+	 * Binary representation of the transaction which is signed and hashed
+	 */
+	GetBodyBytes() ([]byte, error)
+}
+
+type TransactionBase struct {
+	/**
+	 * Base58 encoded blake2b hash of the body bytes
+	 */
+	ID string
+
+	Type    TransactionType
+	Version byte
+
+	/**
+	 * Chain id of the network the transaction is meant for
+	 */
+	Network Network
+
+	Sender          []byte
+	SenderKeyType   KeyType
+	SenderPublicKey []byte
+
+	/**
+	 * Account paying the fee instead of the sender, supported from version 3
+	 */
+	Sponsor          []byte
+	SponsorKeyType   KeyType
+	SponsorPublicKey []byte
+
+	Fee       int64
+	Timestamp int64
+
+	Proofs Proofs
+
+	Height int64
+}
+
+func (b *TransactionBase) GetBase() *TransactionBase {
+	return b
+}
+
+type transactionBaseJSON struct {
+	ID               string          `json:"id,omitempty"`
+	Type             TransactionType `json:"type"`
+	Version          byte            `json:"version"`
+	Sender           string          `json:"sender,omitempty"`
+	SenderKeyType    string          `json:"senderKeyType,omitempty"`
+	SenderPublicKey  string          `json:"senderPublicKey"`
+	Sponsor          string          `json:"sponsor,omitempty"`
+	SponsorKeyType   string          `json:"sponsorKeyType,omitempty"`
+	SponsorPublicKey string          `json:"sponsorPublicKey,omitempty"`
+	Fee              int64           `json:"fee"`
+	Timestamp        int64           `json:"timestamp"`
+	Proofs           []string        `json:"proofs"`
+	Signature        string          `json:"signature,omitempty"`
+	Height           int64           `json:"height,omitempty"`
+}
+
+func (b *TransactionBase) toJSON() transactionBaseJSON {
+	res := transactionBaseJSON{
+		ID:              b.ID,
+		Type:            b.Type,
+		Version:         b.Version,
+		SenderPublicKey: crypto.Base58Encode(b.SenderPublicKey),
+		Fee:             b.Fee,
+		Timestamp:       b.Timestamp,
+		Proofs:          make([]string, len(b.Proofs)),
+		Height:          b.Height,
+	}
+
+	if len(b.Sender) != 0 {
+		res.Sender = crypto.Base58Encode(b.Sender)
+	}
+
+	if b.Version >= 3 {
+		res.SenderKeyType = b.SenderKeyType.String()
+	}
+
+	if len(b.SponsorPublicKey) != 0 {
+		res.Sponsor = crypto.Base58Encode(b.Sponsor)
+		res.SponsorKeyType = b.SponsorKeyType.String()
+		res.SponsorPublicKey = crypto.Base58Encode(b.SponsorPublicKey)
+	}
+
+	for i, proof := range b.Proofs {
+		res.Proofs[i] = crypto.Base58Encode(proof)
+	}
+
+	return res
+}
+
+func (b *TransactionBase) fromJSON(res *transactionBaseJSON) error {
+	keyType, err := parseKeyType(res.SenderKeyType)
+	if err != nil {
+		return err
+	}
+
+	b.Sender, err = decodeBase58("sender", res.Sender)
+	if err != nil {
+		return err
+	}
+
+	b.SenderPublicKey, err = decodeBase58("sender public key", res.SenderPublicKey)
+	if err != nil {
+		return err
+	}
+
+	b.ID = res.ID
+	b.Type = res.Type
+	b.Version = res.Version
+	b.SenderKeyType = keyType
+	b.Fee = res.Fee
+	b.Timestamp = res.Timestamp
+	b.Proofs = make(Proofs, len(res.Proofs))
+	b.Height = res.Height
+
+	if len(b.Sender) == crypto.AddressLength {
+		b.Network = Network(b.Sender[1])
+	}
+
+	if res.SponsorPublicKey != "" {
+		b.SponsorKeyType, err = parseKeyType(res.SponsorKeyType)
+		if err != nil {
+			return err
+		}
+
+		b.Sponsor, err = decodeBase58("sponsor", res.Sponsor)
+		if err != nil {
+			return err
+		}
+
+		b.SponsorPublicKey, err = decodeBase58("sponsor public key", res.SponsorPublicKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, proof := range res.Proofs {
+		b.Proofs[i], err = decodeBase58("proof", proof)
+		if err != nil {
+			return err
+		}
+	}
+
+	// version 1 transactions carry a single signature instead of proofs
+	if len(b.Proofs) == 0 && res.Signature != "" {
+		signature, err := decodeBase58("signature", res.Signature)
+		if err != nil {
+			return err
+		}
+
+		b.Proofs = Proofs{signature}
+	}
+
+	return nil
+}
+
+/**
+ * Decode a base58 field, naming the field if it's invalid
+ */
+func decodeBase58(field string, s string) ([]byte, error) {
+	b, err := crypto.Base58DecodeStrict(s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", field)
+	}
+
+	return b, nil
+}
+
+/**
+ * Versions of each transaction type that can be decoded and serialized
+ */
+var supportedVersions = map[TransactionType][]byte{
+	TransactionTypeGenesis:           {1},
+	TransactionTypeTransfer:          {2, 3},
+	TransactionTypeLease:             {2, 3},
+	TransactionTypeCancelLease:       {2, 3},
+	TransactionTypeMassTransfer:      {1, 3},
+	TransactionTypeData:              {1, 3},
+	TransactionTypeSetScript:         {1, 3},
+	TransactionTypeAnchor:            {1, 3},
+	TransactionTypeAssociation:       {1, 3},
+	TransactionTypeRevokeAssociation: {1, 3},
+	TransactionTypeSponsorship:       {1, 3},
+	TransactionTypeCancelSponsorship: {1, 3},
+}
+
+func isSupportedVersion(txType TransactionType, version byte) bool {
+	for _, v := range supportedVersions[txType] {
+		if v == version {
+			return true
+		}
+	}
+
+	return false
+}
+
+/**
+ * Empty transaction of the type, an UnknownTransaction if the type or version isn't supported
+ */
+func newTransaction(txType TransactionType, version byte) Transaction {
+	if !isSupportedVersion(txType, version) {
+		return new(UnknownTransaction)
+	}
+
+	switch txType {
+	case TransactionTypeGenesis:
+		return new(Genesis)
+	case TransactionTypeTransfer:
+		return new(Transfer)
+	case TransactionTypeLease:
+		return new(Lease)
+	case TransactionTypeCancelLease:
+		return new(CancelLease)
+	case TransactionTypeMassTransfer:
+		return new(MassTransfer)
+	case TransactionTypeData:
+		return new(Data)
+	case TransactionTypeSetScript:
+		return new(SetScript)
+	case TransactionTypeAnchor:
+		return new(Anchor)
+	case TransactionTypeAssociation:
+		return new(Association)
+	case TransactionTypeRevokeAssociation:
+		return new(RevokeAssociation)
+	case TransactionTypeSponsorship:
+		return new(Sponsorship)
+	case TransactionTypeCancelSponsorship:
+		return new(CancelSponsorship)
+	default:
+		return new(UnknownTransaction)
+	}
+}
+
+/**
+ * Decode a transaction from its JSON representation into the struct matching its type. A type or version that
+ * isn't supported gives an UnknownTransaction, only malformed JSON is an error.
+ */
+func DecodeTransaction(data []byte) (Transaction, error) {
+	header := new(struct {
+		Type    TransactionType `json:"type"`
+		Version byte            `json:"version"`
+	})
+
+	err := json.Unmarshal(data, header)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode transaction")
+	}
+
+	tx := newTransaction(header.Type, header.Version)
+
+	err = json.Unmarshal(data, tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode transaction")
+	}
+
+	return tx, nil
+}
+
+func decodeTransactionList(list []json.RawMessage) ([]Transaction, error) {
+	txs := make([]Transaction, len(list))
+
+	for i, data := range list {
+		tx, err := DecodeTransaction(data)
+		if err != nil {
+			return nil, err
+		}
+
+		txs[i] = tx
+	}
+
+	return txs, nil
+}
+
+/**
+ * Set the sender of the transaction and add a proof signed by the account
+ */
+func (a *Account) SignTransaction(tx Transaction) error {
+	base := tx.GetBase()
+
+	if len(base.SenderPublicKey) == 0 {
+		base.Sender = a.Address
+		base.SenderKeyType = KeyTypeED25519
+		base.SenderPublicKey = a.Sign.PublicKey
+	}
+
+	if base.Network == 0 && len(a.Address) > 1 {
+		base.Network = Network(a.Address[1])
+	}
+
+	body, err := tx.GetBodyBytes()
+	if err != nil {
+		return err
+	}
+
+	signature, err := a.SignMessage(body)
+	if err != nil {
+		return err
+	}
+
+	err = base.Proofs.Add(signature)
+	if err != nil {
+		return err
+	}
+
+	base.ID = getTransactionID(body)
+
+	return nil
+}
+
+/**
+ * Co-sign the transaction so the account pays the fee instead of the sender
+ */
+func (a *Account) SponsorTransaction(tx Transaction) error {
+	base := tx.GetBase()
+
+	if base.Version < 3 {
+		return errors.Errorf("sponsoring requires transaction version 3 or higher")
+	}
+
+	if len(base.Proofs) == 0 {
+		return errors.New("transaction must be signed by the sender before it can be sponsored")
+	}
+
+	if len(base.SponsorPublicKey) != 0 {
+		return errors.New("transaction is already sponsored")
+	}
+
+	body, err := tx.GetBodyBytes()
+	if err != nil {
+		return err
+	}
+
+	signature, err := a.SignMessage(body)
+	if err != nil {
+		return err
+	}
+
+	err = base.Proofs.Add(signature)
+	if err != nil {
+		return err
+	}
+
+	// the proof of the sponsor directly follows the one of the sender
+	copy(base.Proofs[2:], base.Proofs[1:])
+	base.Proofs[1] = signature
+
+	base.Sponsor = a.Address
+	base.SponsorKeyType = KeyTypeED25519
+	base.SponsorPublicKey = a.Sign.PublicKey
+
+	return nil
+}
+
+func getTransactionID(body []byte) string {
+	return crypto.Base58Encode(crypto.Blake2b(body))
+}
+
+func getTimestamp() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+/**
+ * Concatenate fixed size values in big endian byte order
+ */
+func writeBinary(values ...interface{}) ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	for _, value := range values {
+		err := binary.Write(buf, binary.BigEndian, value)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to serialize transaction")
+		}
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,204 @@
+package lto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestDecodeTransaction(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    lto.Transaction
+		wantErr bool
+	}{
+		{
+			name: "should decode a genesis transaction",
+			data: `{
+				"type": 1,
+				"version": 1,
+				"id": "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU",
+				"fee": 0,
+				"timestamp": 1519862400000,
+				"signature": "4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd",
+				"recipient": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8",
+				"amount": 1000000000,
+				"height": 1
+			}`,
+			want: &lto.Genesis{
+				TransactionBase: lto.TransactionBase{
+					ID:        "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU",
+					Type:      lto.TransactionTypeGenesis,
+					Version:   1,
+					Network:   lto.NetworkTest,
+					Timestamp: 1519862400000,
+					Proofs:    [][]byte{crypto.Base58Decode("4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd")},
+					Height:    1,
+				},
+				Recipient: crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8"),
+				Amount:    1000000000,
+			},
+		},
+		{
+			name: "should decode a v1 anchor with a signature",
+			data: `{
+				"type": 15,
+				"version": 1,
+				"id": "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU",
+				"sender": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8",
+				"senderPublicKey": "FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y",
+				"fee": 35000000,
+				"timestamp": 1519862400000,
+				"signature": "4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd",
+				"anchors": ["2ar3wSjTm1fA33qgckZ5Kxn1x89gKcDPBXTxw56Yukd"]
+			}`,
+			want: &lto.Anchor{
+				TransactionBase: lto.TransactionBase{
+					ID:              "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU",
+					Type:            lto.TransactionTypeAnchor,
+					Version:         1,
+					Network:         lto.NetworkTest,
+					Sender:          crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8"),
+					SenderKeyType:   lto.KeyTypeED25519,
+					SenderPublicKey: crypto.Base58Decode("FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y"),
+					Fee:             35000000,
+					Timestamp:       1519862400000,
+					Proofs:          [][]byte{crypto.Base58Decode("4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd")},
+				},
+				Anchors: [][]byte{crypto.Base58Decode("2ar3wSjTm1fA33qgckZ5Kxn1x89gKcDPBXTxw56Yukd")},
+			},
+		},
+		{
+			name: "should throw an error for an invalid base58 recipient",
+			data: `{
+				"type": 1,
+				"version": 1,
+				"id": "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU",
+				"signature": "4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd",
+				"recipient": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq0",
+				"amount": 1000000000
+			}`,
+			wantErr: true,
+		},
+		{
+			name: "should throw an error for an invalid base58 sender public key",
+			data: `{
+				"type": 15,
+				"version": 1,
+				"sender": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8",
+				"senderPublicKey": "FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3l",
+				"signature": "4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd",
+				"anchors": []
+			}`,
+			wantErr: true,
+		},
+		{
+			name: "should decode a transaction with a secp256k1 key",
+			data: `{
+				"type": 15,
+				"version": 3,
+				"sender": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8",
+				"senderKeyType": "secp256k1",
+				"senderPublicKey": "FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y",
+				"fee": 35000000,
+				"timestamp": 1519862400000,
+				"proofs": [],
+				"anchors": []
+			}`,
+			want: &lto.Anchor{
+				TransactionBase: lto.TransactionBase{
+					Type:            lto.TransactionTypeAnchor,
+					Version:         3,
+					Network:         lto.NetworkTest,
+					Sender:          crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8"),
+					SenderKeyType:   lto.KeyTypeSecp256k1,
+					SenderPublicKey: crypto.Base58Decode("FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y"),
+					Fee:             35000000,
+					Timestamp:       1519862400000,
+					Proofs:          [][]byte{},
+				},
+				Anchors: [][]byte{},
+			},
+		},
+		{
+			name:    "should throw an error for malformed JSON",
+			data:    `{"type": 4, "version": 3`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lto.DecodeTransaction([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecodeTransaction_Unknown(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "should decode an unknown transaction type",
+			data: `{"type":99,"version":1,"id":"9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU","sender":"3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8","senderPublicKey":"FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y","fee":100000000,"timestamp":1519862400000,"proofs":[],"foo":"bar"}`,
+		},
+		{
+			name: "should decode an unsupported version",
+			data: `{"type":4,"version":1,"id":"9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU","sender":"3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8","senderPublicKey":"FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y","fee":100000000,"timestamp":1519862400000,"signature":"4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd","recipient":"3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8","amount":1}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lto.DecodeTransaction([]byte(tt.data))
+			require.NoError(t, err)
+
+			unknown, ok := got.(*lto.UnknownTransaction)
+			require.True(t, ok)
+			require.Equal(t, "9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU", unknown.ID)
+			require.Equal(t, lto.NetworkTest, unknown.Network)
+			require.Equal(t, int64(1519862400000), unknown.Timestamp)
+
+			data, err := unknown.MarshalJSON()
+			require.NoError(t, err)
+			require.JSONEq(t, tt.data, string(data))
+
+			_, err = unknown.GetBodyBytes()
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDecodeTransaction_BlockWithUnknownType(t *testing.T) {
+	data := `{
+		"version": 3,
+		"timestamp": 1519862400000,
+		"reference": "4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd",
+		"generator": "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8",
+		"signature": "4d5Rrj3sTKj7kNSpTtdxRyrnKZP8XtwcjKV2kytwDkeZBzUtHW9QAQ2Z2YkXYWmoK2kRDg6pBKRGZ1wbcaj4gyWd",
+		"fee": 135000000,
+		"transactionCount": 2,
+		"height": 10,
+		"transactions": [
+			{"type":99,"version":1,"id":"9Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU","senderPublicKey":"FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y","fee":100000000,"timestamp":1519862400000,"proofs":[]},
+			{"type":15,"version":3,"id":"8Nbm2vyfCrHfqcY2JrVSD8H7wvgaT8ew6LsWJZYj9nwU","sender":"3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8","senderKeyType":"ed25519","senderPublicKey":"FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y","fee":35000000,"timestamp":1519862400000,"proofs":[],"anchors":[]}
+		]
+	}`
+
+	block := new(lto.BlocksGetResponse)
+	require.NoError(t, json.Unmarshal([]byte(data), block))
+	require.Len(t, block.Transactions, 2)
+	require.IsType(t, &lto.UnknownTransaction{}, block.Transactions[0])
+	require.IsType(t, &lto.Anchor{}, block.Transactions[1])
+}
@@ -0,0 +1,235 @@
+package lto
+
+import (
+	"encoding/json"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/pkg/errors"
+)
+
+const TransferFee int64 = 100000000
+const TransferDefaultVersion byte = 3
+const MaxAttachmentLength = 140
+
+type transferParams struct {
+	version    byte
+	network    Network
+	recipient  []byte
+	amount     int64
+	attachment []byte
+	fee        int64
+	timestamp  int64
+	fees       *FeeCalculator
+}
+
+func NewTransfer() *transferParams {
+	return &transferParams{
+		version:   TransferDefaultVersion,
+		timestamp: getTimestamp(),
+	}
+}
+
+func (p *transferParams) Create() (*Transfer, error) {
+	if p.version != 2 && p.version != 3 {
+		return nil, errors.Errorf("unsupported transfer version %d", p.version)
+	}
+
+	if len(p.recipient) != crypto.AddressLength {
+		return nil, errors.New("invalid recipient")
+	}
+
+	if p.network != 0 && !crypto.IsValidAddress(p.recipient, byte(p.network)) {
+		return nil, errors.New("recipient is not a valid address for the network")
+	}
+
+	if p.amount <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	if len(p.attachment) > MaxAttachmentLength {
+		return nil, errors.Errorf("attachment must not be longer than %d bytes", MaxAttachmentLength)
+	}
+
+	tx := &Transfer{
+		TransactionBase: TransactionBase{
+			Type:      TransactionTypeTransfer,
+			Version:   p.version,
+			Network:   p.network,
+			Fee:       p.fee,
+			Timestamp: p.timestamp,
+		},
+		Recipient:  p.recipient,
+		Amount:     p.amount,
+		Attachment: p.attachment,
+	}
+
+	err := fillFee(tx, p.fees)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (p *transferParams) WithRecipient(recipient []byte) *transferParams {
+	p.recipient = recipient
+	return p
+}
+
+func (p *transferParams) WithAmount(amount int64) *transferParams {
+	p.amount = amount
+	return p
+}
+
+func (p *transferParams) WithAttachment(attachment []byte) *transferParams {
+	p.attachment = attachment
+	return p
+}
+
+func (p *transferParams) WithFee(fee int64) *transferParams {
+	p.fee = fee
+	return p
+}
+
+func (p *transferParams) WithTimestamp(timestamp int64) *transferParams {
+	p.timestamp = timestamp
+	return p
+}
+
+func (p *transferParams) WithVersion(version byte) *transferParams {
+	p.version = version
+	return p
+}
+
+func (p *transferParams) WithNetwork(network Network) *transferParams {
+	p.network = network
+	return p
+}
+
+func (p *transferParams) WithNetworkConfig(config *Config) *transferParams {
+	p.network = config.Network
+	p.fees = NewFeeCalculator(config.BasicConfig)
+	return p
+}
+
+type Transfer struct {
+	TransactionBase
+
+	/**
+	 * Address receiving the amount
+	 */
+	Recipient []byte
+
+	/**
+	 * Amount of LTO in the smallest unit (1 LTO = 100000000)
+	 */
+	Amount int64
+
+	/**
+	 * Arbitrary data of at most 140 bytes
+	 */
+	Attachment []byte
+}
+
+func (t *Transfer) GetBodyBytes() ([]byte, error) {
+	if len(t.SenderPublicKey) == 0 {
+		return nil, errors.New("first set sender before creating body bytes")
+	}
+
+	switch t.Version {
+	case 2:
+		return writeBinary(
+			t.Type,
+			t.Version,
+			t.SenderPublicKey,
+			t.Timestamp,
+			t.Amount,
+			t.Fee,
+			t.Recipient,
+			uint16(len(t.Attachment)),
+			t.Attachment,
+		)
+	case 3:
+		if t.Network == 0 {
+			return nil, errors.New("network unknown")
+		}
+
+		return writeBinary(
+			t.Type,
+			t.Version,
+			t.Network,
+			t.Timestamp,
+			t.SenderKeyType,
+			t.SenderPublicKey,
+			t.Fee,
+			t.Recipient,
+			t.Amount,
+			uint16(len(t.Attachment)),
+			t.Attachment,
+		)
+	default:
+		return nil, errors.Errorf("unsupported transfer version %d", t.Version)
+	}
+}
+
+func (t *Transfer) SignWith(account *Account) (*Transfer, error) {
+	err := account.SignTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *Transfer) SponsorWith(account *Account) (*Transfer, error) {
+	err := account.SponsorTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type transferJSON struct {
+	transactionBaseJSON
+	Recipient  string `json:"recipient"`
+	Amount     int64  `json:"amount"`
+	Attachment string `json:"attachment"`
+}
+
+func (t *Transfer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&transferJSON{
+		transactionBaseJSON: t.toJSON(),
+		Recipient:           crypto.Base58Encode(t.Recipient),
+		Amount:              t.Amount,
+		Attachment:          crypto.Base58Encode(t.Attachment),
+	})
+}
+
+func (t *Transfer) UnmarshalJSON(data []byte) error {
+	res := new(transferJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	err = t.fromJSON(&res.transactionBaseJSON)
+	if err != nil {
+		return err
+	}
+
+	t.Recipient, err = decodeBase58("recipient", res.Recipient)
+	if err != nil {
+		return err
+	}
+
+	t.Attachment, err = decodeBase58("attachment", res.Attachment)
+	if err != nil {
+		return err
+	}
+
+	t.Amount = res.Amount
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package lto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+func TestTransfer_GetBodyBytes(t *testing.T) {
+	publicKey := crypto.Base58Decode("FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y")
+	recipient := crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")
+
+	type fields struct {
+		version    byte
+		attachment []byte
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "should serialize a v2 transfer",
+			fields: fields{
+				version:    2,
+				attachment: []byte("hi"),
+			},
+			want: concat(
+				[]byte{4, 2},
+				publicKey,
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{0, 0, 0, 0, 0x3b, 0x9a, 0xca, 0x00},
+				[]byte{0, 0, 0, 0, 0x05, 0xf5, 0xe1, 0x00},
+				recipient,
+				[]byte{0, 2, 'h', 'i'},
+			),
+		},
+		{
+			name: "should serialize a v3 transfer",
+			fields: fields{
+				version: 3,
+			},
+			want: concat(
+				[]byte{4, 3, 'T'},
+				[]byte{0, 0, 1, 0x61, 0xde, 0xdb, 0xc4, 0x00},
+				[]byte{1},
+				publicKey,
+				[]byte{0, 0, 0, 0, 0x05, 0xf5, 0xe1, 0x00},
+				recipient,
+				[]byte{0, 0, 0, 0, 0x3b, 0x9a, 0xca, 0x00},
+				[]byte{0, 0},
+			),
+		},
+		{
+			name: "should fail for an unsupported version",
+			fields: fields{
+				version: 1,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := lto.NewAccount().
+				FromPrivateKey(crypto.Base58Decode("wJ4WH8dD88fSkNdFQRjaAhjFUZzZhV5yiDLDwNUnp6bYwRXrvWV8MJhQ9HL9uqMDG1n7XpTGZx7PafqaayQV8Rp")).
+				WithNetwork(lto.NetworkTest).
+				Create()
+			require.NoError(t, err)
+
+			tx, err := lto.NewTransfer().
+				WithVersion(tt.fields.version).
+				WithRecipient(recipient).
+				WithAmount(1000000000).
+				WithAttachment(tt.fields.attachment).
+				WithTimestamp(1519862400000).
+				Create()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			tx, err = tx.SignWith(a)
+			require.NoError(t, err)
+
+			body, err := tx.GetBodyBytes()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, body)
+
+			require.Equal(t, crypto.Base58Encode(crypto.Blake2b(body)), tx.ID)
+			require.Len(t, tx.Proofs, 1)
+
+			valid, err := a.Verify(tx.Proofs[0], body)
+			require.NoError(t, err)
+			require.True(t, valid)
+		})
+	}
+}
+
+func Test_NewTransferCreate(t *testing.T) {
+	type fields struct {
+		recipient  []byte
+		amount     int64
+		attachment []byte
+		network    lto.Network
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{
+			name: "should create a transfer",
+			fields: fields{
+				recipient: crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8"),
+				amount:    100,
+			},
+		},
+		{
+			name: "should throw an error for an invalid recipient",
+			fields: fields{
+				recipient: []byte("foo"),
+				amount:    100,
+			},
+			wantErr: true,
+		},
+		{
+			name: "should throw an error for a recipient of another network",
+			fields: fields{
+				recipient: crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8"),
+				amount:    100,
+				network:   lto.NetworkMain,
+			},
+			wantErr: true,
+		},
+		{
+			name: "should throw an error for a zero amount",
+			fields: fields{
+				recipient: crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should throw an error for a long attachment",
+			fields: fields{
+				recipient:  crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8"),
+				amount:     100,
+				attachment: make([]byte, 141),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := lto.NewTransfer().
+				WithRecipient(tt.fields.recipient).
+				WithAmount(tt.fields.amount).
+				WithAttachment(tt.fields.attachment).
+				WithNetwork(tt.fields.network).
+				Create()
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, lto.TransferFee, tx.Fee)
+			require.Equal(t, lto.TransferDefaultVersion, tx.Version)
+		})
+	}
+}
+
+func TestTransfer_MarshalJSON(t *testing.T) {
+	a, err := lto.NewAccount().
+		FromPrivateKey(crypto.Base58Decode("wJ4WH8dD88fSkNdFQRjaAhjFUZzZhV5yiDLDwNUnp6bYwRXrvWV8MJhQ9HL9uqMDG1n7XpTGZx7PafqaayQV8Rp")).
+		WithNetwork(lto.NetworkTest).
+		Create()
+	require.NoError(t, err)
+
+	tx, err := lto.NewTransfer().
+		WithRecipient(crypto.Base58Decode("3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8")).
+		WithAmount(1000000000).
+		WithAttachment([]byte("hello")).
+		WithTimestamp(1519862400000).
+		Create()
+	require.NoError(t, err)
+
+	tx, err = tx.SignWith(a)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(tx)
+	require.NoError(t, err)
+
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &got))
+
+	require.Equal(t, tx.ID, got["id"])
+	require.Equal(t, float64(4), got["type"])
+	require.Equal(t, float64(3), got["version"])
+	require.Equal(t, crypto.Base58Encode(a.Address), got["sender"])
+	require.Equal(t, "ed25519", got["senderKeyType"])
+	require.Equal(t, "FkU1XyfrCftc4pQKXCrrDyRLSnifX1SMvmx1CYiiyB3Y", got["senderPublicKey"])
+	require.Equal(t, float64(lto.TransferFee), got["fee"])
+	require.Equal(t, float64(1519862400000), got["timestamp"])
+	require.Equal(t, "3N6mZMgGqYn9EVAR2Vbf637iej4fFipECq8", got["recipient"])
+	require.Equal(t, float64(1000000000), got["amount"])
+	require.Equal(t, crypto.Base58Encode([]byte("hello")), got["attachment"])
+	require.Equal(t, []interface{}{crypto.Base58Encode(tx.Proofs[0])}, got["proofs"])
+}
+
+func concat(parts ...[]byte) []byte {
+	var res []byte
+	for _, part := range parts {
+		res = append(res, part...)
+	}
+
+	return res
+}
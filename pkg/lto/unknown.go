@@ -0,0 +1,47 @@
+package lto
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+/**
+ * Transaction of a type or version the SDK doesn't model, e.g. found in a block. Only the base fields are decoded,
+ * the JSON is kept as is.
+ */
+type UnknownTransaction struct {
+	TransactionBase
+
+	Raw json.RawMessage
+}
+
+func (t *UnknownTransaction) GetBodyBytes() ([]byte, error) {
+	return nil, errors.Errorf("unsupported version %d for transaction type %d", t.Version, t.Type)
+}
+
+func (t *UnknownTransaction) MarshalJSON() ([]byte, error) {
+	if len(t.Raw) == 0 {
+		return nil, errors.New("unknown transaction has no JSON")
+	}
+
+	return t.Raw, nil
+}
+
+func (t *UnknownTransaction) UnmarshalJSON(data []byte) error {
+	res := new(transactionBaseJSON)
+
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return err
+	}
+
+	err = t.fromJSON(res)
+	if err != nil {
+		return err
+	}
+
+	t.Raw = append(json.RawMessage{}, data...)
+
+	return nil
+}
@@ -0,0 +1,436 @@
+package ltotest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+)
+
+/**
+ * Error response in the format of the node
+ */
+type nodeError struct {
+	status  int
+	Code    int             `json:"error"`
+	Message string          `json:"message"`
+	Tx      json.RawMessage `json:"tx,omitempty"`
+}
+
+func stateCheckFailed(reason string, tx []byte) *nodeError {
+	return &nodeError{
+		status:  http.StatusBadRequest,
+		Code:    lto.APIErrorStateCheckFailed,
+		Message: "State check failed. Reason: " + reason,
+		Tx:      tx,
+	}
+}
+
+var errWrongJSON = &nodeError{status: http.StatusBadRequest, Code: 1, Message: "failed to parse json message"}
+var errBlockNotFound = &nodeError{status: http.StatusNotFound, Code: lto.APIErrorBlockDoesNotExist, Message: "block does not exist"}
+var errTransactionNotFound = &nodeError{status: http.StatusNotFound, Code: lto.APIErrorTransactionDoesNotExist, Message: "transactions does not exist"}
+var errNotFound = &nodeError{status: http.StatusNotFound, Message: "not found"}
+
+func errInvalidSender(tx []byte) *nodeError {
+	return &nodeError{status: http.StatusBadRequest, Code: lto.APIErrorInvalidSender, Message: "invalid sender", Tx: tx}
+}
+
+func errInvalidSponsor(tx []byte) *nodeError {
+	return &nodeError{status: http.StatusBadRequest, Code: lto.APIErrorCustomValidation, Message: "invalid sponsor", Tx: tx}
+}
+
+func (n *Node) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	var res interface{}
+	var err *nodeError
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/transactions/broadcast":
+		res, err = n.broadcast(r)
+	case r.Method == http.MethodPost && r.URL.Path == "/transactions/calculateFee":
+		res, err = n.calculateFee(r)
+	case r.Method != http.MethodGet:
+		err = errNotFound
+	case path[0] == "addresses":
+		res, err = n.addresses(path[1:])
+	case path[0] == "blocks":
+		res, err = n.blocksRoute(path[1:])
+	case path[0] == "transactions":
+		res, err = n.transactionsRoute(path[1:], r)
+	case path[0] == "leasing" && len(path) == 3 && path[1] == "active":
+		res = n.activeLeases(path[2])
+	case r.URL.Path == "/utils/time":
+		res = map[string]int64{"system": now(), "NTP": now()}
+	default:
+		err = errNotFound
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err != nil {
+		w.WriteHeader(err.status)
+		_ = json.NewEncoder(w).Encode(err)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func (n *Node) addresses(path []string) (interface{}, *nodeError) {
+	switch {
+	case len(path) == 2 && path[0] == "balance":
+		return n.balance(path[1], 0), nil
+	case len(path) == 3 && path[0] == "balance" && path[1] == "details":
+		address := path[2]
+		available := n.state.available(address)
+		effective := available + n.state.leasedIn(address)
+
+		return map[string]interface{}{
+			"address":    address,
+			"regular":    n.state.balance(address),
+			"generating": effective,
+			"available":  available,
+			"effective":  effective,
+		}, nil
+	case len(path) == 3 && path[0] == "balance":
+		confirmations, err := strconv.ParseInt(path[2], 10, 64)
+		if err != nil {
+			return nil, errNotFound
+		}
+		return n.balance(path[1], confirmations), nil
+	default:
+		return nil, errNotFound
+	}
+}
+
+func (n *Node) balance(address string, confirmations int64) interface{} {
+	return map[string]interface{}{
+		"address":       address,
+		"confirmations": confirmations,
+		"balance":       n.state.balance(address),
+	}
+}
+
+func (n *Node) blocksRoute(path []string) (interface{}, *nodeError) {
+	ints := make([]int64, len(path))
+	for i, part := range path {
+		ints[i], _ = strconv.ParseInt(part, 10, 64)
+	}
+
+	switch {
+	case len(path) == 1 && path[0] == "height":
+		return map[string]int64{"height": int64(len(n.blocks))}, nil
+	case len(path) == 1 && path[0] == "first":
+		return n.blocks[0], nil
+	case len(path) == 1 && path[0] == "last":
+		return n.blocks[len(n.blocks)-1], nil
+	case len(path) == 2 && path[0] == "at":
+		return n.blockAt(ints[1])
+	case len(path) == 2 && path[0] == "signature":
+		return n.blockBySignature(path[1])
+	case len(path) == 2 && path[0] == "height":
+		block, err := n.blockBySignature(path[1])
+		if err != nil {
+			return nil, err
+		}
+		return map[string]int64{"height": block.Height}, nil
+	case len(path) == 2 && path[0] == "child":
+		block, err := n.blockBySignature(path[1])
+		if err != nil {
+			return nil, err
+		}
+		return n.blockAt(block.Height + 1)
+	case len(path) == 3 && path[0] == "seq":
+		return n.blockSeq(ints[1], ints[2]), nil
+	case len(path) == 2 && path[0] == "headers" && path[1] == "last":
+		return header(n.blocks[len(n.blocks)-1]), nil
+	case len(path) == 4 && path[0] == "headers" && path[1] == "seq":
+		var headers []*lto.BlockHeader
+		for _, block := range n.blockSeq(ints[2], ints[3]) {
+			headers = append(headers, header(block))
+		}
+		return headers, nil
+	case len(path) == 4 && path[0] == "address":
+		blocks := []*lto.BlocksGetResponse{}
+		for _, block := range n.blockSeq(ints[2], ints[3]) {
+			if block.Generator == path[1] {
+				blocks = append(blocks, block)
+			}
+		}
+		return blocks, nil
+	case len(path) == 3 && path[0] == "delay":
+		return n.delay(path[1], ints[2])
+	default:
+		return nil, errNotFound
+	}
+}
+
+func (n *Node) blockAt(height int64) (*lto.BlocksGetResponse, *nodeError) {
+	if height < 1 || height > int64(len(n.blocks)) {
+		return nil, errBlockNotFound
+	}
+
+	return n.blocks[height-1], nil
+}
+
+func (n *Node) blockBySignature(signature string) (*lto.BlocksGetResponse, *nodeError) {
+	for _, block := range n.blocks {
+		if block.Signature == signature {
+			return block, nil
+		}
+	}
+
+	return nil, errBlockNotFound
+}
+
+func (n *Node) blockSeq(from int64, to int64) []*lto.BlocksGetResponse {
+	blocks := []*lto.BlocksGetResponse{}
+	for height := from; height <= to; height++ {
+		block, err := n.blockAt(height)
+		if err != nil {
+			break
+		}
+		blocks = append(blocks, block)
+	}
+
+	return blocks
+}
+
+func (n *Node) delay(signature string, blockNum int64) (interface{}, *nodeError) {
+	block, err := n.blockBySignature(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	first := block.Height - blockNum
+	if first < 1 || blockNum < 1 {
+		return nil, errBlockNotFound
+	}
+
+	delay := (block.Timestamp - n.blocks[first-1].Timestamp) / blockNum
+
+	return map[string]int64{"delay": delay}, nil
+}
+
+func header(block *lto.BlocksGetResponse) *lto.BlockHeader {
+	header := block.BlockHeader
+	return &header
+}
+
+func (n *Node) transactionsRoute(path []string, r *http.Request) (interface{}, *nodeError) {
+	switch {
+	case len(path) == 2 && path[0] == "info":
+		tx, ok := n.transactions[path[1]]
+		if !ok {
+			return nil, errTransactionNotFound
+		}
+		return tx, nil
+	case len(path) == 4 && path[0] == "address" && path[2] == "limit":
+		limit, err := strconv.Atoi(path[3])
+		if err != nil {
+			return nil, errNotFound
+		}
+		return [][]lto.Transaction{n.addressTransactions(path[1], limit, r.URL.Query().Get("after"))}, nil
+	case len(path) == 1 && path[0] == "unconfirmed":
+		if n.unconfirmed == nil {
+			return []lto.Transaction{}, nil
+		}
+		return n.unconfirmed, nil
+	case len(path) == 2 && path[0] == "unconfirmed" && path[1] == "size":
+		return map[string]int{"size": len(n.unconfirmed)}, nil
+	case len(path) == 3 && path[0] == "unconfirmed" && path[1] == "info":
+		tx := n.unconfirmedTransaction(path[2])
+		if tx == nil {
+			return nil, errTransactionNotFound
+		}
+		return tx, nil
+	default:
+		return nil, errNotFound
+	}
+}
+
+/**
+ * Confirmed transactions involving the address, newest first
+ */
+func (n *Node) addressTransactions(address string, limit int, after string) []lto.Transaction {
+	txs := []lto.Transaction{}
+	found := after == ""
+
+	for i := len(n.blocks) - 1; i >= 0 && len(txs) < limit; i-- {
+		blockTxs := n.blocks[i].Transactions
+		for j := len(blockTxs) - 1; j >= 0 && len(txs) < limit; j-- {
+			tx := blockTxs[j]
+
+			if !found {
+				found = tx.GetBase().ID == after
+				continue
+			}
+
+			if involves(tx, address) {
+				txs = append(txs, tx)
+			}
+		}
+	}
+
+	return txs
+}
+
+func involves(tx lto.Transaction, address string) bool {
+	base := tx.GetBase()
+	addresses := [][]byte{base.Sender, base.Sponsor}
+
+	switch tx := tx.(type) {
+	case *lto.Genesis:
+		addresses = append(addresses, tx.Recipient)
+	case *lto.Transfer:
+		addresses = append(addresses, tx.Recipient)
+	case *lto.Lease:
+		addresses = append(addresses, tx.Recipient)
+	case *lto.MassTransfer:
+		for _, transfer := range tx.Transfers {
+			addresses = append(addresses, transfer.Recipient)
+		}
+	}
+
+	for _, a := range addresses {
+		if len(a) != 0 && crypto.Base58Encode(a) == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (n *Node) activeLeases(address string) []*lto.Lease {
+	leases := []*lto.Lease{}
+	for _, lease := range n.state.leases {
+		if crypto.Base58Encode(lease.Sender) == address || crypto.Base58Encode(lease.Recipient) == address {
+			leases = append(leases, lease)
+		}
+	}
+
+	return leases
+}
+
+func (n *Node) calculateFee(r *http.Request) (interface{}, *nodeError) {
+	tx, data, err := decodeRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, feeErr := lto.NewFeeCalculator(nil).Calculate(tx)
+	if feeErr != nil {
+		return nil, stateCheckFailed(feeErr.Error(), data)
+	}
+
+	return map[string]interface{}{"feeAssetId": nil, "feeAmount": fee}, nil
+}
+
+/**
+ * Validate the transaction against the chain and the unconfirmed pool and add it to the pool
+ */
+func (n *Node) broadcast(r *http.Request) (interface{}, *nodeError) {
+	tx, data, err := decodeRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	base := tx.GetBase()
+
+	if base.Network != n.network {
+		return nil, &nodeError{
+			status:  http.StatusBadRequest,
+			Code:    lto.APIErrorCustomValidation,
+			Message: fmt.Sprintf("transaction is for network %c instead of %c", base.Network, n.network),
+			Tx:      data,
+		}
+	}
+
+	if !bytes.Equal(base.Sender, crypto.BuildRawAddress(base.SenderPublicKey, byte(n.network))) {
+		return nil, errInvalidSender(data)
+	}
+
+	// the sponsor pays the fee, so it must match the public key that co-signs
+	if (len(base.Sponsor) != 0 || len(base.SponsorPublicKey) != 0) &&
+		!bytes.Equal(base.Sponsor, crypto.BuildRawAddress(base.SponsorPublicKey, byte(n.network))) {
+		return nil, errInvalidSponsor(data)
+	}
+
+	body, bodyErr := tx.GetBodyBytes()
+	if bodyErr != nil {
+		return nil, &nodeError{status: http.StatusBadRequest, Code: lto.APIErrorCustomValidation, Message: bodyErr.Error(), Tx: data}
+	}
+	base.ID = crypto.Base58Encode(crypto.Blake2b(body))
+
+	if _, ok := n.transactions[base.ID]; ok {
+		return nil, &nodeError{
+			status:  http.StatusBadRequest,
+			Code:    lto.APIErrorCustomValidation,
+			Message: fmt.Sprintf("Transaction %s is already in the state", base.ID),
+			Tx:      data,
+		}
+	}
+
+	if n.unconfirmedTransaction(base.ID) != nil {
+		return nil, &nodeError{
+			status:  http.StatusBadRequest,
+			Code:    lto.APIErrorCustomValidation,
+			Message: fmt.Sprintf("Transaction %s is already in the pool", base.ID),
+			Tx:      data,
+		}
+	}
+
+	if lto.VerifyProofs(tx) != nil {
+		return nil, stateCheckFailed("Proof doesn't validate as signature", data)
+	}
+
+	fee, feeErr := lto.NewFeeCalculator(nil).Calculate(tx)
+	if feeErr != nil {
+		return nil, stateCheckFailed(feeErr.Error(), data)
+	}
+
+	if base.Fee < fee {
+		return nil, stateCheckFailed(fmt.Sprintf("Fee %d does not exceed minimal value of %d", base.Fee, fee), data)
+	}
+
+	pending := n.state.clone()
+	applyErr := pending.applyAll(append(n.unconfirmed, tx))
+	if applyErr != nil {
+		return nil, stateCheckFailed(applyErr.Error(), data)
+	}
+
+	n.unconfirmed = append(n.unconfirmed, tx)
+
+	if n.autoMine {
+		_, mineErr := n.mine()
+		if mineErr != nil {
+			return nil, stateCheckFailed(mineErr.Error(), data)
+		}
+	}
+
+	return tx, nil
+}
+
+func decodeRequest(r *http.Request) (lto.Transaction, []byte, *nodeError) {
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, nil, errWrongJSON
+	}
+
+	tx, err := lto.DecodeTransaction(bytes.TrimSpace(data))
+	if err != nil {
+		return nil, nil, errWrongJSON
+	}
+
+	return tx, data, nil
+}
@@ -0,0 +1,282 @@
+/**
+ * In-process fake LTO node for testing without network access.
+ *
+ * The node keeps balances, blocks and an unconfirmed pool in memory. Broadcast transactions are
+ * validated like a real node would, including signatures, fees and balances, and are added to a
+ * block by Mine.
+ */
+package ltotest
+
+import (
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+	"github.com/pkg/errors"
+)
+
+type genesisBalance struct {
+	address lto.Address
+	amount  int64
+}
+
+type nodeParams struct {
+	network  lto.Network
+	balances []*genesisBalance
+	autoMine bool
+}
+
+func NewNode() *nodeParams {
+	return &nodeParams{
+		network: lto.NetworkTest,
+	}
+}
+
+/**
+ * Start the node, the genesis block holds the balances
+ */
+func (p *nodeParams) Create() (*Node, error) {
+	generator, err := lto.NewAccount().WithNetwork(p.network).Create()
+	if err != nil {
+		return nil, err
+	}
+
+	n := &Node{
+		network:      p.network,
+		generator:    generator,
+		autoMine:     p.autoMine,
+		state:        newState(),
+		transactions: make(map[string]lto.Transaction),
+	}
+
+	genesis := make([]lto.Transaction, len(p.balances))
+	for i, balance := range p.balances {
+		if !crypto.IsValidAddress(balance.address, byte(p.network)) {
+			return nil, errors.Errorf("invalid address %s", crypto.Base58Encode(balance.address))
+		}
+
+		tx := &lto.Genesis{
+			Recipient: balance.address,
+			Amount:    balance.amount,
+		}
+		tx.Type = lto.TransactionTypeGenesis
+		tx.Version = 1
+		tx.Network = p.network
+		tx.Timestamp = now()
+
+		body, err := tx.GetBodyBytes()
+		if err != nil {
+			return nil, err
+		}
+		tx.ID = crypto.Base58Encode(crypto.Blake2b(body))
+
+		genesis[i] = tx
+	}
+
+	err = n.state.applyAll(genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = n.addBlock(genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	n.server = httptest.NewServer(n)
+
+	return n, nil
+}
+
+func (p *nodeParams) WithNetwork(network lto.Network) *nodeParams {
+	p.network = network
+	return p
+}
+
+/**
+ * Give the address a balance in the genesis block
+ */
+func (p *nodeParams) WithBalance(address lto.Address, amount int64) *nodeParams {
+	p.balances = append(p.balances, &genesisBalance{address: address, amount: amount})
+	return p
+}
+
+/**
+ * Mine a block for each accepted transaction, so it's confirmed right away
+ */
+func (p *nodeParams) WithAutoMine() *nodeParams {
+	p.autoMine = true
+	return p
+}
+
+type Node struct {
+	mutex     sync.Mutex
+	server    *httptest.Server
+	network   lto.Network
+	generator *lto.Account
+	autoMine  bool
+
+	/**
+	 * State of the chain, without the unconfirmed transactions
+	 */
+	state *state
+
+	blocks       []*lto.BlocksGetResponse
+	transactions map[string]lto.Transaction
+	unconfirmed  []lto.Transaction
+}
+
+func (n *Node) URL() string {
+	return n.server.URL
+}
+
+func (n *Node) Close() {
+	n.server.Close()
+}
+
+/**
+ * Config of the network of the node, requests are not retried as the node is always available
+ */
+func (n *Node) Config() *lto.Config {
+	config := lto.DefaultTestNetConfig()
+	if n.network == lto.NetworkMain {
+		config = lto.DefaultMainNetConfig()
+	}
+
+	config.Network = n.network
+	config.NodeAddress = n.server.URL
+	config.RetryCount = 0
+
+	return config
+}
+
+func (n *Node) Client() (*lto.Client, error) {
+	return lto.NewClient().WithNetworkConfig(n.Config()).Create()
+}
+
+/**
+ * Add a block with all unconfirmed transactions
+ */
+func (n *Node) Mine() (*lto.BlocksGetResponse, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	return n.mine()
+}
+
+func (n *Node) mine() (*lto.BlocksGetResponse, error) {
+	err := n.state.applyAll(n.unconfirmed)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := n.addBlock(n.unconfirmed)
+	if err != nil {
+		return nil, err
+	}
+
+	n.unconfirmed = nil
+
+	return block, nil
+}
+
+func (n *Node) addBlock(txs []lto.Transaction) (*lto.BlocksGetResponse, error) {
+	block := &lto.BlocksGetResponse{
+		BlockHeader: lto.BlockHeader{
+			Version:          3,
+			Timestamp:        now(),
+			Generator:        crypto.Base58Encode(n.generator.Address),
+			TransactionCount: int64(len(txs)),
+			Height:           int64(len(n.blocks) + 1),
+		},
+		Transactions: txs,
+	}
+
+	if block.Transactions == nil {
+		block.Transactions = []lto.Transaction{}
+	}
+
+	if len(n.blocks) != 0 {
+		block.Reference = n.blocks[len(n.blocks)-1].Signature
+	}
+
+	reference, err := crypto.Base58DecodeStrict(block.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range txs {
+		base := tx.GetBase()
+		base.Height = block.Height
+		block.Fee += base.Fee
+		n.transactions[base.ID] = tx
+	}
+
+	message := append(reference, crypto.Blake2b([]byte(block.Generator))...)
+	signature, err := n.generator.SignMessage(append(message, byte(block.Height)))
+	if err != nil {
+		return nil, err
+	}
+	block.Signature = crypto.Base58Encode(signature)
+
+	n.blocks = append(n.blocks, block)
+
+	return block, nil
+}
+
+func (n *Node) Height() int64 {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	return int64(len(n.blocks))
+}
+
+/**
+ * Confirmed balance of the address
+ */
+func (n *Node) Balance(address lto.Address) int64 {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	return n.state.balance(crypto.Base58Encode(address))
+}
+
+/**
+ * Set the confirmed balance of the address, e.g. to fund an account in the middle of a test
+ */
+func (n *Node) SetBalance(address lto.Address, amount int64) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.state.balances[crypto.Base58Encode(address)] = amount
+}
+
+/**
+ * Get a confirmed or unconfirmed transaction, nil if the node doesn't know it
+ */
+func (n *Node) Transaction(id string) lto.Transaction {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if tx, ok := n.transactions[id]; ok {
+		return tx
+	}
+
+	return n.unconfirmedTransaction(id)
+}
+
+func (n *Node) unconfirmedTransaction(id string) lto.Transaction {
+	for _, tx := range n.unconfirmed {
+		if tx.GetBase().ID == id {
+			return tx
+		}
+	}
+
+	return nil
+}
+
+func now() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
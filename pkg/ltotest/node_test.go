@@ -0,0 +1,217 @@
+package ltotest_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+	"github.com/ltonetwork/lto-sdk.go/pkg/ltotest"
+)
+
+func newAccount(t *testing.T) *lto.Account {
+	a, err := lto.NewAccount().WithNetwork(lto.NetworkTest).Create()
+	require.NoError(t, err)
+
+	return a
+}
+
+func TestNode_Broadcast(t *testing.T) {
+	alice := newAccount(t)
+	bob := newAccount(t)
+
+	node, err := ltotest.NewNode().
+		WithBalance(alice.Address, 10*100000000).
+		Create()
+	require.NoError(t, err)
+	defer node.Close()
+
+	client, err := node.Client()
+	require.NoError(t, err)
+
+	t.Run("should confirm a transfer when a block is mined", func(t *testing.T) {
+		tx, err := client.NewTransfer().WithRecipient(bob.Address).WithAmount(100000000).Create()
+		require.NoError(t, err)
+
+		tx, err = tx.SignWith(alice)
+		require.NoError(t, err)
+
+		_, err = client.TransactionsBroadcast(tx)
+		require.NoError(t, err)
+
+		size, err := client.TransactionsUTXSize()
+		require.NoError(t, err)
+		require.Equal(t, int64(1), size.Size)
+
+		_, err = client.TransactionsBroadcast(tx)
+		require.True(t, lto.IsAlreadyInUTX(err))
+
+		block, err := node.Mine()
+		require.NoError(t, err)
+		require.Equal(t, int64(2), block.Height)
+
+		confirmed, err := client.TransactionsGet(tx.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), confirmed.GetBase().Height)
+
+		balance, err := client.AddressBalance(bob.Address)
+		require.NoError(t, err)
+		require.Equal(t, int64(100000000), balance.Balance)
+		require.Equal(t, int64(10*100000000-100000000-lto.TransferFee), node.Balance(alice.Address))
+	})
+
+	t.Run("should reject an invalid signature", func(t *testing.T) {
+		tx, err := client.NewTransfer().WithRecipient(bob.Address).WithAmount(100).Create()
+		require.NoError(t, err)
+
+		tx, err = tx.SignWith(alice)
+		require.NoError(t, err)
+
+		tx.Amount = 200
+
+		_, err = client.TransactionsBroadcast(tx)
+		require.True(t, lto.IsInvalidSignature(err))
+	})
+
+	t.Run("should reject a sender that doesn't match the public key", func(t *testing.T) {
+		tx, err := client.NewTransfer().WithRecipient(bob.Address).WithAmount(100).Create()
+		require.NoError(t, err)
+
+		tx, err = tx.SignWith(alice)
+		require.NoError(t, err)
+
+		tx.Sender = bob.Address
+
+		_, err = client.TransactionsBroadcast(tx)
+		require.Error(t, err)
+		require.Equal(t, lto.APIErrorInvalidSender, err.(*lto.APIError).Code)
+	})
+
+	t.Run("should reject a sponsor that doesn't match the public key", func(t *testing.T) {
+		mallory := newAccount(t)
+
+		tx, err := client.NewAnchor().WithAnchors(make([]byte, 32)).Create()
+		require.NoError(t, err)
+
+		tx, err = tx.SignWith(mallory)
+		require.NoError(t, err)
+
+		tx, err = tx.SponsorWith(mallory)
+		require.NoError(t, err)
+
+		tx.Sponsor = alice.Address
+		balance := node.Balance(alice.Address)
+
+		_, err = client.TransactionsBroadcast(tx)
+		require.Error(t, err)
+		require.Equal(t, "invalid sponsor", err.(*lto.APIError).Message)
+		require.Equal(t, balance, node.Balance(alice.Address))
+	})
+
+	t.Run("should reject a transaction only signed by the sponsor", func(t *testing.T) {
+		mallory := newAccount(t)
+
+		tx, err := client.NewTransfer().WithRecipient(mallory.Address).WithAmount(5 * 100000000).Create()
+		require.NoError(t, err)
+
+		require.NoError(t, tx.SetSender(alice.Sign.PublicKey))
+		require.NoError(t, tx.SetSponsor(mallory.Sign.PublicKey))
+
+		body, err := tx.GetBodyBytes()
+		require.NoError(t, err)
+
+		signature, err := mallory.SignMessage(body)
+		require.NoError(t, err)
+		require.NoError(t, tx.Proofs.Add(signature))
+
+		balance := node.Balance(alice.Address)
+
+		_, err = client.TransactionsBroadcast(tx)
+		require.True(t, lto.IsInvalidSignature(err))
+
+		_, err = node.Mine()
+		require.NoError(t, err)
+		require.Equal(t, balance, node.Balance(alice.Address))
+	})
+
+	t.Run("should reject a transaction exceeding the balance", func(t *testing.T) {
+		tx, err := client.NewTransfer().WithRecipient(alice.Address).WithAmount(1).Create()
+		require.NoError(t, err)
+
+		tx, err = tx.SignWith(newAccount(t))
+		require.NoError(t, err)
+
+		_, err = client.TransactionsBroadcast(tx)
+		require.True(t, lto.IsInsufficientBalance(err))
+	})
+
+	t.Run("should reject a fee below the minimum", func(t *testing.T) {
+		tx, err := client.NewAnchor().WithAnchors(make([]byte, 32)).WithFee(1).Create()
+		require.NoError(t, err)
+
+		tx, err = tx.SignWith(alice)
+		require.NoError(t, err)
+
+		_, err = client.TransactionsBroadcast(tx)
+		require.Error(t, err)
+		require.Equal(t, lto.APIErrorStateCheckFailed, err.(*lto.APIError).Code)
+	})
+}
+
+func TestNode_Lease(t *testing.T) {
+	alice := newAccount(t)
+	bob := newAccount(t)
+
+	node, err := ltotest.NewNode().
+		WithBalance(alice.Address, 10*100000000).
+		WithAutoMine().
+		Create()
+	require.NoError(t, err)
+	defer node.Close()
+
+	client, err := node.Client()
+	require.NoError(t, err)
+
+	lease, err := client.NewLease().WithRecipient(bob.Address).WithAmount(5 * 100000000).Create()
+	require.NoError(t, err)
+
+	lease, err = lease.SignWith(alice)
+	require.NoError(t, err)
+
+	_, err = client.TransactionsBroadcast(lease)
+	require.NoError(t, err)
+
+	active, err := client.LeasingActive(bob.Address)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	require.Equal(t, lease.ID, active[0].ID)
+
+	details, err := client.AddressBalanceDetails(alice.Address)
+	require.NoError(t, err)
+	require.Equal(t, int64(10*100000000-lto.LeaseFee), details.Regular)
+	require.Equal(t, int64(5*100000000-lto.LeaseFee), details.Available)
+
+	transfer, err := client.NewTransfer().WithRecipient(bob.Address).WithAmount(5 * 100000000).Create()
+	require.NoError(t, err)
+
+	transfer, err = transfer.SignWith(alice)
+	require.NoError(t, err)
+
+	_, err = client.TransactionsBroadcast(transfer)
+	require.True(t, lto.IsInsufficientBalance(err))
+
+	cancel, err := client.NewCancelLease().WithLeaseID(lease.ID).Create()
+	require.NoError(t, err)
+
+	cancel, err = cancel.SignWith(alice)
+	require.NoError(t, err)
+
+	_, err = client.TransactionsBroadcast(cancel)
+	require.NoError(t, err)
+
+	active, err = client.LeasingActive(bob.Address)
+	require.NoError(t, err)
+	require.Empty(t, active)
+
+	require.Equal(t, int64(3), node.Height())
+}
@@ -0,0 +1,132 @@
+package ltotest
+
+import (
+	"github.com/ltonetwork/lto-sdk.go/pkg/crypto"
+	"github.com/ltonetwork/lto-sdk.go/pkg/lto"
+	"github.com/pkg/errors"
+)
+
+var errNegativeBalance = errors.New("negative lto balance")
+var errLeaseNotFound = errors.New("lease not found")
+
+/**
+ * Balances and active leases, addresses are base58 encoded
+ */
+type state struct {
+	balances map[string]int64
+	leases   map[string]*lto.Lease
+}
+
+func newState() *state {
+	return &state{
+		balances: make(map[string]int64),
+		leases:   make(map[string]*lto.Lease),
+	}
+}
+
+func (s *state) clone() *state {
+	c := newState()
+
+	for address, balance := range s.balances {
+		c.balances[address] = balance
+	}
+
+	for id, lease := range s.leases {
+		c.leases[id] = lease
+	}
+
+	return c
+}
+
+func (s *state) balance(address string) int64 {
+	return s.balances[address]
+}
+
+func (s *state) leasedOut(address string) int64 {
+	var amount int64
+	for _, lease := range s.leases {
+		if crypto.Base58Encode(lease.Sender) == address {
+			amount += lease.Amount
+		}
+	}
+
+	return amount
+}
+
+func (s *state) leasedIn(address string) int64 {
+	var amount int64
+	for _, lease := range s.leases {
+		if crypto.Base58Encode(lease.Recipient) == address {
+			amount += lease.Amount
+		}
+	}
+
+	return amount
+}
+
+func (s *state) available(address string) int64 {
+	return s.balance(address) - s.leasedOut(address)
+}
+
+func (s *state) applyAll(txs []lto.Transaction) error {
+	for _, tx := range txs {
+		err := s.apply(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/**
+ * Apply the transaction, the state is left in an undefined state on error so apply to a clone when validating
+ */
+func (s *state) apply(tx lto.Transaction) error {
+	base := tx.GetBase()
+
+	payer := base.Sender
+	if len(base.Sponsor) != 0 {
+		payer = base.Sponsor
+	}
+
+	var touched []string
+	if len(payer) != 0 {
+		touched = append(touched, crypto.Base58Encode(payer))
+		s.balances[touched[0]] -= base.Fee
+	}
+
+	sender := crypto.Base58Encode(base.Sender)
+
+	switch tx := tx.(type) {
+	case *lto.Genesis:
+		s.balances[crypto.Base58Encode(tx.Recipient)] += tx.Amount
+	case *lto.Transfer:
+		s.balances[sender] -= tx.Amount
+		s.balances[crypto.Base58Encode(tx.Recipient)] += tx.Amount
+		touched = append(touched, sender)
+	case *lto.MassTransfer:
+		for _, transfer := range tx.Transfers {
+			s.balances[sender] -= transfer.Amount
+			s.balances[crypto.Base58Encode(transfer.Recipient)] += transfer.Amount
+		}
+		touched = append(touched, sender)
+	case *lto.Lease:
+		s.leases[tx.ID] = tx
+		touched = append(touched, sender)
+	case *lto.CancelLease:
+		lease, ok := s.leases[tx.LeaseID]
+		if !ok || crypto.Base58Encode(lease.Sender) != sender {
+			return errLeaseNotFound
+		}
+		delete(s.leases, tx.LeaseID)
+	}
+
+	for _, address := range touched {
+		if s.available(address) < 0 {
+			return errNegativeBalance
+		}
+	}
+
+	return nil
+}